@@ -450,16 +450,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
 				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
 			} else {
-				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
-					result := <-ec.DeferredResults
-					atomic.AddInt32(&ec.PendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
+				return ec.NextDeferredResponse()
 			}
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
@@ -3509,7 +3500,7 @@ func (ec *executionContext) _Cat(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("Cat")
 		case "id":
 			out.Values[i] = ec._Cat_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "batchProp":
@@ -3522,7 +3513,7 @@ func (ec *executionContext) _Cat(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._Cat_batchProp(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3585,7 +3576,7 @@ func (ec *executionContext) _Dog(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("Dog")
 		case "id":
 			out.Values[i] = ec._Dog_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "batchProp":
@@ -3598,7 +3589,7 @@ func (ec *executionContext) _Dog(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._Dog_batchProp(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3661,7 +3652,7 @@ func (ec *executionContext) _DomesticCat(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = graphql.MarshalString("DomesticCat")
 		case "id":
 			out.Values[i] = ec._DomesticCat_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "batchProp":
@@ -3674,7 +3665,7 @@ func (ec *executionContext) _DomesticCat(ctx context.Context, sel ast.SelectionS
 					}
 				}()
 				res = ec._DomesticCat_batchProp(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3704,7 +3695,7 @@ func (ec *executionContext) _DomesticCat(ctx context.Context, sel ast.SelectionS
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "name":
 			out.Values[i] = ec._DomesticCat_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "batchName":
@@ -3717,7 +3708,7 @@ func (ec *executionContext) _DomesticCat(ctx context.Context, sel ast.SelectionS
 					}
 				}()
 				res = ec._DomesticCat_batchName(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3780,7 +3771,7 @@ func (ec *executionContext) _Image(ctx context.Context, sel ast.SelectionSet, ob
 			out.Values[i] = graphql.MarshalString("Image")
 		case "url":
 			out.Values[i] = ec._Image_url(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -3818,7 +3809,7 @@ func (ec *executionContext) _Pig(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("Pig")
 		case "id":
 			out.Values[i] = ec._Pig_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "batchProp":
@@ -3831,7 +3822,7 @@ func (ec *executionContext) _Pig(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._Pig_batchProp(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3894,7 +3885,7 @@ func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = graphql.MarshalString("Profile")
 		case "id":
 			out.Values[i] = ec._Profile_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "coverBatch":
@@ -3907,7 +3898,7 @@ func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet,
 					}
 				}()
 				res = ec._Profile_coverBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -3945,7 +3936,7 @@ func (ec *executionContext) _Profile(ctx context.Context, sel ast.SelectionSet,
 					}
 				}()
 				res = ec._Profile_coverNonBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4016,7 +4007,7 @@ func (ec *executionContext) _ProfileEdge(ctx context.Context, sel ast.SelectionS
 					}
 				}()
 				res = ec._ProfileEdge_node(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4046,7 +4037,7 @@ func (ec *executionContext) _ProfileEdge(ctx context.Context, sel ast.SelectionS
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "cursor":
 			out.Values[i] = ec._ProfileEdge_cursor(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		default:
@@ -4084,12 +4075,12 @@ func (ec *executionContext) _ProfilesConnection(ctx context.Context, sel ast.Sel
 			out.Values[i] = graphql.MarshalString("ProfilesConnection")
 		case "edges":
 			out.Values[i] = ec._ProfilesConnection_edges(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "totalCount":
 			out.Values[i] = ec._ProfilesConnection_totalCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -4143,7 +4134,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					}
 				}()
 				res = ec._Query_users(ctx, field)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4165,7 +4156,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					}
 				}()
 				res = ec._Query_animals(ctx, field)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4187,7 +4178,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					}
 				}()
 				res = ec._Query_pets(ctx, field)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4203,14 +4194,14 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Query___type(ctx, field)
 			})
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "__schema":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Query___schema(ctx, field)
 			})
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		default:
@@ -4256,7 +4247,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nullableBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4294,7 +4285,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nullableNonBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4332,7 +4323,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nullableBatchWithArg(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4370,7 +4361,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nullableNonBatchWithArg(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4408,7 +4399,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nonNullableBatch(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4446,7 +4437,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_nonNullableNonBatch(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4484,7 +4475,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNullableBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4522,7 +4513,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNullableNonBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4560,7 +4551,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNullableBatchWithArg(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4598,7 +4589,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNullableNonBatchWithArg(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4636,7 +4627,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNonNullableBatch(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4674,7 +4665,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_directiveNonNullableNonBatch(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4712,7 +4703,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_profileBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4750,7 +4741,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_profileNonBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4788,7 +4779,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_profileConnectionBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4826,7 +4817,7 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 					}
 				}()
 				res = ec._User_profileConnectionNonBatch(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -4889,27 +4880,27 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = graphql.MarshalString("__Directive")
 		case "name":
 			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "description":
 			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "isRepeatable":
 			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "locations":
 			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "args":
 			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -4947,22 +4938,22 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = graphql.MarshalString("__EnumValue")
 		case "name":
 			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "description":
 			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "isDeprecated":
 			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "deprecationReason":
 			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -5000,32 +4991,32 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = graphql.MarshalString("__Field")
 		case "name":
 			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "description":
 			out.Values[i] = ec.___Field_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "args":
 			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "type":
 			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "isDeprecated":
 			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "deprecationReason":
 			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -5063,32 +5054,32 @@ func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.Selection
 			out.Values[i] = graphql.MarshalString("__InputValue")
 		case "name":
 			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "description":
 			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "type":
 			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "defaultValue":
 			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "isDeprecated":
 			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "deprecationReason":
 			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -5126,32 +5117,32 @@ func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = graphql.MarshalString("__Schema")
 		case "description":
 			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "types":
 			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "queryType":
 			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "mutationType":
 			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "subscriptionType":
 			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "directives":
 			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -5189,57 +5180,57 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = graphql.MarshalString("__Type")
 		case "kind":
 			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "name":
 			out.Values[i] = ec.___Type_name(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "description":
 			out.Values[i] = ec.___Type_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "specifiedByURL":
 			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "fields":
 			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "interfaces":
 			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "possibleTypes":
 			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "enumValues":
 			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "inputFields":
 			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "ofType":
 			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "isOneOf":
 			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -5335,7 +5326,7 @@ func (ec *executionContext) marshalNAnimal2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5345,7 +5336,7 @@ func (ec *executionContext) marshalNAnimal2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
@@ -5361,7 +5352,7 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
@@ -5377,7 +5368,7 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
@@ -5426,7 +5417,7 @@ func (ec *executionContext) marshalNPet2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋ_
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5461,7 +5452,7 @@ func (ec *executionContext) marshalNProfileEdge2ᚕgithubᚗcomᚋ99designsᚋgq
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5471,7 +5462,7 @@ func (ec *executionContext) marshalNProfileEdge2ᚕgithubᚗcomᚋ99designsᚋgq
 
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
@@ -5494,7 +5485,7 @@ func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgen
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5524,7 +5515,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5534,7 +5525,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 
 func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
@@ -5570,7 +5561,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5598,7 +5589,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5618,7 +5609,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5638,7 +5629,7 @@ func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgen
 
 func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
@@ -5654,7 +5645,7 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
@@ -5669,7 +5660,7 @@ func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any
 		return nil, nil
 	}
 	res, err := graphql.UnmarshalBoolean(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return &res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
@@ -5708,7 +5699,7 @@ func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v an
 		return nil, nil
 	}
 	res, err := graphql.UnmarshalString(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return &res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
@@ -5732,7 +5723,7 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5751,7 +5742,7 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5770,7 +5761,7 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -5796,7 +5787,7 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
@@ -45,6 +45,40 @@ func TestClient(t *testing.T) {
 	require.Equal(t, "bob", resp.Name)
 }
 
+func TestClientMultipartSubscription(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept"), `subscriptionSpec="1.0"`)
+		w.Header().Set(
+			"Content-Type",
+			`multipart/mixed;boundary="graphql";subscriptionSpec="1.0"`,
+		)
+		for _, part := range []string{
+			`{}`,
+			`{"payload":{"data":{"name":"bob"}}}`,
+			`{"payload":{"data":null,"errors":[{"message":"not found"}]}}`,
+			`{"payload":null,"errors":[{"message":"stream failed"}]}`,
+		} {
+			fmt.Fprintf(w, "--graphql\r\nContent-Type: application/json\r\n\r\n%s\r\n", part)
+		}
+		fmt.Fprint(w, "--graphql--\r\n")
+	})
+
+	c := client.New(h)
+	sub := c.MultipartSubscription(t.Context(), "subscription { name }")
+	defer sub.Close()
+
+	var resp struct {
+		Data struct {
+			Name string
+		}
+	}
+	require.NoError(t, sub.Next(&resp))
+	require.Equal(t, "bob", resp.Data.Name)
+
+	require.EqualError(t, sub.Next(&resp), `[{"message":"not found"}]`)
+	require.EqualError(t, sub.Next(&resp), `[{"message":"stream failed"}]`)
+}
+
 func TestClientMultipartFormData(t *testing.T) {
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		bodyBytes, err := io.ReadAll(r.Body)
@@ -35,12 +35,12 @@ type IncrementalInitialResponse struct {
 }
 
 type IncrementalData struct {
-	// Support for "items" for @stream is not yet available, only "data" for
-	// @defer, as per the 2023 spec. Similarly, this retains a more complete
-	// list of fields, but not "id," and represents a mid-point between the
-	// 2022 and 2023 specs.
+	// Holds "data" for @defer and "items" for @stream. This retains a more
+	// complete list of fields than the 2023 spec, but not "id," and
+	// represents a mid-point between the 2022 and 2023 specs.
 
 	Data       any             `json:"data"`
+	Items      any             `json:"items,omitempty"`
 	Label      string          `json:"label"`
 	Path       []any           `json:"path"`
 	HasNext    bool            `json:"hasNext"`
@@ -148,35 +148,13 @@ func (p *Client) IncrementalHTTP(
 			var data any
 			var rawErrors json.RawMessage
 
-			type nextPart struct {
-				*multipart.Part
-				Err error
-			}
-
-			nextPartCh := make(chan nextPart)
-			go func() {
-				var next nextPart
-				next.Part, next.Err = mr.NextPart()
-				nextPartCh <- next
-			}()
-
-			var next nextPart
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case next = <-nextPartCh:
-			}
-
-			if next.Err == io.EOF {
+			next, err := nextJSONPart(ctx, mr)
+			if err == io.EOF {
 				res.Body.Close()
 				cancel(context.Canceled)
 				return nil
 			}
-			if err = next.Err; err != nil {
-				return err
-			}
-			if ct := next.Header.Get("Content-Type"); ct != "application/json" {
-				err = fmt.Errorf(`expected content-type "application/json", got %q`, ct)
+			if err != nil {
 				return err
 			}
 
@@ -186,7 +164,7 @@ func (p *Client) IncrementalHTTP(
 			} else {
 				data = IncrementalResponse{}
 			}
-			if err = json.NewDecoder(next.Part).Decode(&data); err != nil {
+			if err = json.NewDecoder(next).Decode(&data); err != nil {
 				return err
 			}
 
@@ -201,3 +179,136 @@ func (p *Client) IncrementalHTTP(
 		},
 	}
 }
+
+// MultipartSubscription returns a GraphQL response handler for the
+// [multipart HTTP subscription protocol] of Apollo, as served by
+// transport.MultipartSubscription. Heartbeat parts are skipped, and Next
+// unpacks the payload of each part, that is the whole GraphQL response.
+// Errors ending the subscription, sent outside of the payload, are returned
+// by Next as a RawJsonError.
+//
+// [multipart HTTP subscription protocol]:
+// https://www.apollographql.com/docs/graphos/routing/operations/subscriptions/multipart-protocol
+func (p *Client) MultipartSubscription(
+	ctx context.Context,
+	query string,
+	options ...Option,
+) *IncrementalHandler {
+	r, err := p.newRequest(query, options...)
+	if err != nil {
+		return errorIncremental(fmt.Errorf("request: %w", err))
+	}
+	r.Header.Set("Accept", `multipart/mixed;subscriptionSpec="1.0", application/json`)
+
+	w := httptest.NewRecorder()
+	p.h.ServeHTTP(w, r)
+
+	res := w.Result()
+	if res.StatusCode >= http.StatusBadRequest {
+		return errorIncremental(fmt.Errorf("http %d: %s", w.Code, w.Body.String()))
+	}
+	mediaType, params, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil {
+		return errorIncremental(fmt.Errorf("parse content-type: %w", err))
+	}
+	if mediaType != "multipart/mixed" {
+		return errorIncremental(
+			fmt.Errorf("expected content-type multipart/mixed, got %s", mediaType),
+		)
+	}
+	if params["subscriptionspec"] == "" {
+		return errorIncremental(errors.New("expected subscriptionSpec in content-type"))
+	}
+	boundary, ok := params["boundary"]
+	if !ok || boundary == "" {
+		return errorIncremental(errors.New("expected boundary in content-type"))
+	}
+	mr := multipart.NewReader(res.Body, boundary)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	return &IncrementalHandler{
+		close: func() error {
+			res.Body.Close()
+			cancel(context.Canceled)
+			return nil
+		},
+		next: func(response any) error {
+			// Errors of the stream end it, unlike the errors of its responses.
+			fail := func(err error) error {
+				res.Body.Close()
+				cancel(err)
+				return err
+			}
+
+			for {
+				next, err := nextJSONPart(ctx, mr)
+				if err == io.EOF {
+					res.Body.Close()
+					cancel(context.Canceled)
+					return nil
+				}
+				if err != nil {
+					return fail(err)
+				}
+
+				var message struct {
+					Payload map[string]any  `json:"payload"`
+					Errors  json.RawMessage `json:"errors"`
+				}
+				if err = json.NewDecoder(next).Decode(&message); err != nil {
+					return fail(err)
+				}
+				if len(message.Errors) != 0 {
+					return fail(RawJsonError{message.Errors})
+				}
+				if message.Payload == nil {
+					// heartbeat
+					continue
+				}
+
+				// We want to unpack even if there is an error, so we can see partial
+				// responses.
+				err = unpack(message.Payload, response, p.dc)
+				if errs, ok := message.Payload["errors"]; ok {
+					raw, err := json.Marshal(errs)
+					if err != nil {
+						return fmt.Errorf("encode errors: %w", err)
+					}
+					return RawJsonError{raw}
+				}
+				return err
+			}
+		},
+	}
+}
+
+// nextJSONPart reads the next part of mr, which must be JSON, until ctx is done.
+func nextJSONPart(ctx context.Context, mr *multipart.Reader) (*multipart.Part, error) {
+	type nextPart struct {
+		*multipart.Part
+		Err error
+	}
+
+	nextPartCh := make(chan nextPart, 1)
+	go func() {
+		var next nextPart
+		next.Part, next.Err = mr.NextPart()
+		nextPartCh <- next
+	}()
+
+	var next nextPart
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case next = <-nextPartCh:
+	}
+
+	if next.Err != nil {
+		return nil, next.Err
+	}
+	if ct := next.Header.Get("Content-Type"); ct != "application/json" {
+		return nil, fmt.Errorf(`expected content-type "application/json", got %q`, ct)
+	}
+	return next.Part, nil
+}
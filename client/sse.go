@@ -13,15 +13,22 @@ import (
 type SSE struct {
 	Close func() error
 	Next  func(response any) error
+	// LastEventID returns the id of the last event read by Next, to resume the subscription from
+	// with the Last-Event-ID header.
+	LastEventID func() string
 }
 
+// SSEResponse is a response sent over SSE. The responses following an initial response with
+// hasNext deliver deferred fragments and streamed items in Incremental.
 type SSEResponse struct {
-	Data       any             `json:"data"`
-	Label      string          `json:"label"`
-	Path       []any           `json:"path"`
-	HasNext    bool            `json:"hasNext"`
-	Errors     json.RawMessage `json:"errors"`
-	Extensions map[string]any  `json:"extensions"`
+	Data        any               `json:"data,omitempty"`
+	Items       any               `json:"items,omitempty"`
+	Incremental []IncrementalData `json:"incremental,omitempty"`
+	Label       string            `json:"label,omitempty"`
+	Path        []any             `json:"path,omitempty"`
+	HasNext     bool              `json:"hasNext"`
+	Errors      json.RawMessage   `json:"errors,omitempty"`
+	Extensions  map[string]any    `json:"extensions,omitempty"`
 }
 
 func errorSSE(err error) *SSE {
@@ -30,6 +37,7 @@ func errorSSE(err error) *SSE {
 		Next: func(response any) error {
 			return err
 		},
+		LastEventID: func() string { return "" },
 	}
 }
 
@@ -56,10 +64,14 @@ func (p *Client) SSE(ctx context.Context, query string, options ...Option) *SSE
 		return errorSSE(fmt.Errorf("expected :, got %s", line))
 	}
 
+	var lastEventID string
 	return &SSE{
 		Close: func() error {
 			return nil
 		},
+		LastEventID: func() string {
+			return lastEventID
+		},
 		Next: func(response any) error {
 			for {
 				line, err := reader.ReadLine()
@@ -80,6 +92,9 @@ func (p *Client) SSE(ctx context.Context, query string, options ...Option) *SSE
 					default:
 						return fmt.Errorf("expected event type: %#v", kv[1])
 					}
+				case "id":
+					lastEventID = kv[1]
+					continue
 				case "data":
 					var respDataRaw SSEResponse
 					if err = json.Unmarshal([]byte(kv[1]), &respDataRaw); err != nil {
@@ -31,6 +31,9 @@ type operationMessage struct {
 type Subscription struct {
 	Close func() error
 	Next  func(response any) error
+	// NextResponse unpacks the whole payload of the next message rather than its data, for
+	// reading the hasNext and incremental fields of operations using @defer or @stream.
+	NextResponse func(response any) error
 }
 
 func errorSubscription(err error) *Subscription {
@@ -39,6 +42,9 @@ func errorSubscription(err error) *Subscription {
 		Next: func(response any) error {
 			return err
 		},
+		NextResponse: func(response any) error {
+			return err
+		},
 	}
 }
 
@@ -134,40 +140,70 @@ func (p *Client) WebsocketWithPayload(
 		return errorSubscription(fmt.Errorf("start: %w", err))
 	}
 
+	readPayload := func() (json.RawMessage, error) {
+		for {
+			op, err := readWebsocketJSON(c)
+			if err != nil {
+				return nil, err
+			}
+
+			switch op.Type {
+			case dataMsg:
+				return op.Payload, nil
+			case connectionKaMsg:
+				continue
+			case errorMsg:
+				return nil, errors.New(string(op.Payload))
+			default:
+				return nil, fmt.Errorf("expected data message, got %#v", op)
+			}
+		}
+	}
+
 	return &Subscription{
 		Close: closeFn,
 		Next: func(response any) error {
-			for {
-				op, err := readWebsocketJSON(c)
-				if err != nil {
-					return err
-				}
+			payload, err := readPayload()
+			if err != nil {
+				return err
+			}
 
-				switch op.Type {
-				case dataMsg:
-					break
-				case connectionKaMsg:
-					continue
-				case errorMsg:
-					return errors.New(string(op.Payload))
-				default:
-					return fmt.Errorf("expected data message, got %#v", op)
-				}
+			var respDataRaw Response
+			err = json.Unmarshal(payload, &respDataRaw)
+			if err != nil {
+				return fmt.Errorf("decode: %w", err)
+			}
 
-				var respDataRaw Response
-				err = json.Unmarshal(op.Payload, &respDataRaw)
-				if err != nil {
-					return fmt.Errorf("decode: %w", err)
-				}
+			// we want to unpack even if there is an error, so we can see partial responses
+			unpackErr := unpack(respDataRaw.Data, response, p.dc)
 
-				// we want to unpack even if there is an error, so we can see partial responses
-				unpackErr := unpack(respDataRaw.Data, response, p.dc)
+			if respDataRaw.Errors != nil {
+				return RawJsonError{respDataRaw.Errors}
+			}
+			return unpackErr
+		},
+		NextResponse: func(response any) error {
+			payload, err := readPayload()
+			if err != nil {
+				return err
+			}
+
+			var respDataRaw map[string]any
+			if err = json.Unmarshal(payload, &respDataRaw); err != nil {
+				return fmt.Errorf("decode: %w", err)
+			}
 
-				if respDataRaw.Errors != nil {
-					return RawJsonError{respDataRaw.Errors}
+			// we want to unpack even if there is an error, so we can see partial responses
+			unpackErr := unpack(respDataRaw, response, p.dc)
+
+			if errs, ok := respDataRaw["errors"]; ok {
+				raw, err := json.Marshal(errs)
+				if err != nil {
+					return fmt.Errorf("encode errors: %w", err)
 				}
-				return unpackErr
+				return RawJsonError{raw}
 			}
+			return unpackErr
 		},
 	}
 }
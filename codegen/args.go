@@ -25,6 +25,13 @@ type FieldArgument struct {
 	Directives                     []*Directive
 	Value                          any // value set in Data
 	CallArgumentDirectivesWithNull bool
+	// ConstraintRules are the Go expressions of the rules validating the argument, from its
+	// @constraint directive
+	ConstraintRules string
+	// ConstraintRulesVar is the name of the package variable holding ConstraintRules
+	ConstraintRulesVar string
+	// Constrained is set when the argument, or any input field in its type, has @constraint
+	Constrained bool
 }
 
 // ImplDirectives get not SkipRuntime and location ARGUMENT_DEFINITION directive
@@ -73,6 +80,13 @@ func (b *builder) buildArg(obj *Object, arg *ast.ArgumentDefinition) (*FieldArgu
 		CallArgumentDirectivesWithNull: b.Config.CallArgumentDirectivesWithNull,
 	}
 
+	newArg.ConstraintRules, err = b.constraintRules(arg.Directives)
+	if err != nil {
+		return nil, fmt.Errorf("argument %s: %w", arg.Name, err)
+	}
+	newArg.Constrained = newArg.ConstraintRules != "" ||
+		b.hasConstraints(arg.Type, map[string]bool{})
+
 	if arg.DefaultValue != nil {
 		newArg.Default, err = arg.DefaultValue.Value(nil)
 		if err != nil {
@@ -117,6 +131,17 @@ nextArg:
 	return newArgs, nil
 }
 
+// HasConstrainedArgs reports whether any of args is validated by @constraint, in which case the
+// violations of all of them are collected before being returned.
+func (d *Data) HasConstrainedArgs(args []*FieldArgument) bool {
+	for _, arg := range args {
+		if arg.Constrained {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *Data) Args() map[string][]*FieldArgument {
 	ret := map[string][]*FieldArgument{}
 	for _, o := range d.Objects {
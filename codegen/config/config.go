@@ -19,6 +19,7 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql/introspection"
 	"github.com/99designs/gqlgen/internal/code"
 	"github.com/99designs/gqlgen/internal/imports"
 )
@@ -35,6 +36,7 @@ type Config struct {
 	StructTag                            string                     `yaml:"struct_tag,omitempty"`
 	EmbeddedStructsPrefix                string                     `yaml:"embedded_structs_prefix,omitempty"`
 	Directives                           map[string]DirectiveConfig `yaml:"directives,omitempty"`
+	Contracts                            []ContractConfig           `yaml:"contracts,omitempty"`
 	LocalPrefix                          string                     `yaml:"local_prefix,omitempty"`
 	GoBuildTags                          StringList                 `yaml:"go_build_tags,omitempty"`
 	GoInitialisms                        GoInitialismsConfig        `yaml:"go_initialisms,omitempty"`
@@ -62,8 +64,13 @@ type Config struct {
 	NullableInputOmittable         bool  `yaml:"nullable_input_omittable,omitempty"`
 	EnableModelJsonOmitemptyTag    *bool `yaml:"enable_model_json_omitempty_tag,omitempty"`
 	EnableModelJsonOmitzeroTag     *bool `yaml:"enable_model_json_omitzero_tag,omitempty"`
+	EnableModelInputValidate       bool  `yaml:"enable_model_input_validate,omitempty"`
+	OneOfInterfaces                bool  `yaml:"oneof_interfaces,omitempty"`
 	SkipValidation                 bool  `yaml:"skip_validation,omitempty"`
 	SkipModTidy                    bool  `yaml:"skip_mod_tidy,omitempty"`
+	// OnErrorIntrospection adds the __ErrorBehavior enum and __Schema.defaultErrorBehavior of
+	// the proposed onError request parameter to the introspection of the generated schema.
+	OnErrorIntrospection bool `yaml:"on_error_introspection,omitempty"`
 	// FastValidation uses -gcflags="-N -l" to disable compiler optimizations
 	// during validation, making cold cache validation ~2x faster. The generated
 	// code is only used for error checking, not execution. Default: false
@@ -116,6 +123,12 @@ const (
 	DirGoEnum              = "goEnum"
 	DirInlineArguments     = "inlineArguments"
 	DirSubscriptionContext = "subscriptionContext"
+	DirCacheControl        = "cacheControl"
+	DirCached              = "cached"
+	DirSensitive           = "sensitive"
+	DirSemanticNonNull     = "semanticNonNull"
+	DirConstraint          = "constraint"
+	DirStream              = "stream"
 
 	DirArgName                = "name"
 	DirArgModel               = "model"
@@ -404,6 +417,7 @@ func (c *Config) injectTypesFromSchema() error {
 		DirGoEnum,
 		DirInlineArguments,
 		DirSubscriptionContext,
+		DirCacheControl,
 	} {
 		c.Directives[d] = DirectiveConfig{SkipRuntime: true}
 	}
@@ -430,9 +444,61 @@ func (c *Config) injectTypesFromSchema() error {
 		c.injectGoEnumDirectives(schemaType)
 	}
 
+	c.injectCachedDirective()
+
+	// @sensitive only annotates the schema for graphql.Sensitivity, it has no resolver
+	if _, configured := c.Directives[DirSensitive]; c.Schema.Directives[DirSensitive] != nil &&
+		!configured {
+		c.Directives[DirSensitive] = DirectiveConfig{SkipRuntime: true}
+	}
+
+	// @semanticNonNull changes the generated Go types and is checked by the executor itself
+	_, configured := c.Directives[DirSemanticNonNull]
+	if c.Schema.Directives[DirSemanticNonNull] != nil && !configured {
+		c.Directives[DirSemanticNonNull] = DirectiveConfig{SkipRuntime: true}
+	}
+
+	// @constraint is validated by the generated unmarshalers, unless it is configured to run
+	// as a user directive
+	_, configured = c.Directives[DirConstraint]
+	if c.Schema.Directives[DirConstraint] != nil && !configured {
+		c.Directives[DirConstraint] = DirectiveConfig{SkipRuntime: true}
+	}
+
+	// @stream is run by the executor on the list fields it is used on
+	_, configured = c.Directives[DirStream]
+	if c.Schema.Directives[DirStream] != nil && !configured {
+		c.Directives[DirStream] = DirectiveConfig{SkipRuntime: true}
+	}
+
+	if _, configured := c.Directives["tag"]; len(c.Contracts) > 0 && !configured {
+		c.Directives["tag"] = DirectiveConfig{SkipRuntime: true}
+	}
+
 	return nil
 }
 
+// injectCachedDirective implements @cached with graphql.CachedDirective, unless the directive is
+// configured explicitly or its arguments differ from those of the runtime implementation, in
+// which case it is left to the resolvers like any other directive.
+func (c *Config) injectCachedDirective() {
+	def := c.Schema.Directives[DirCached]
+	if def == nil {
+		return
+	}
+	if _, configured := c.Directives[DirCached]; configured {
+		return
+	}
+	if len(def.Arguments) != 2 ||
+		def.Arguments[0].Name != "ttl" || def.Arguments[0].Type.String() != "Int!" ||
+		def.Arguments[1].Name != "key" || def.Arguments[1].Type.String() != "String" {
+		return
+	}
+
+	implementation := "graphql.CachedDirective"
+	c.Directives[DirCached] = DirectiveConfig{Implementation: &implementation}
+}
+
 func (c *Config) injectGoModelDirective(schemaType *ast.Definition) {
 	bd := schemaType.Directives.ForName(DirGoModel)
 	if bd == nil {
@@ -756,6 +822,11 @@ type TypeMapField struct {
 	// in a single call, reducing N+1 query problems. For partial failures, return
 	// a graphql.BatchErrors implementation as the error.
 	Batch *bool `yaml:"batch,omitempty"`
+
+	// StreamSource makes the resolver of a list field return its items lazily, so that @stream
+	// delivers each of them as soon as it is produced: "seq" returns an iter.Seq of the items
+	// and "chan" a channel of them, which the resolver closes once they are all sent.
+	StreamSource string `yaml:"streamSource,omitempty"`
 	// ForceGenerate forces the field to be generated in the model struct
 	// even when OmitResolverFields is enabled and the field has forceResolver: true.
 	ForceGenerate bool `yaml:"forceGenerate"`
@@ -880,6 +951,23 @@ func (c *Config) check() error {
 		}
 	}
 
+	contracts := map[string]bool{}
+	for i := range c.Contracts {
+		contract := &c.Contracts[i]
+		if err := contract.Check(); err != nil {
+			return fmt.Errorf("config.contracts[%d]: %w", i, err)
+		}
+		if contracts[contract.Name] {
+			return fmt.Errorf("config.contracts[%d]: duplicate contract %s", i, contract.Name)
+		}
+		for _, f := range c.SchemaFilename {
+			if abs(f) == contract.Filename {
+				return fmt.Errorf("config.contracts[%d]: %s is also a schema file", i, f)
+			}
+		}
+		contracts[contract.Name] = true
+	}
+
 	for importPath, pkg := range fileList {
 		for _, file1 := range pkg {
 			for _, file2 := range pkg {
@@ -991,6 +1079,37 @@ type DirectiveConfig struct {
 	Implementation *string
 }
 
+// ContractConfig describes a schema contract: a variant of the schema selected by @tag
+// directives, written to its own SDL file and served by its own generated
+// New<Name>ExecutableSchema constructor.
+type ContractConfig struct {
+	Name        string   `yaml:"name"`
+	Filename    string   `yaml:"filename"`
+	IncludeTags []string `yaml:"include_tags,omitempty"`
+	ExcludeTags []string `yaml:"exclude_tags,omitempty"`
+}
+
+// Check validates the name and filename of the contract, and makes the filename absolute.
+func (c *ContractConfig) Check() error {
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+	if !validContractName.MatchString(c.Name) {
+		return fmt.Errorf("name %s must only contain letters, digits and underscores", c.Name)
+	}
+	if c.Filename == "" {
+		return fmt.Errorf("filename is required for contract %s", c.Name)
+	}
+	if !strings.HasSuffix(c.Filename, ".graphql") && !strings.HasSuffix(c.Filename, ".graphqls") {
+		return fmt.Errorf("filename %s for contract %s must end with .graphql or .graphqls",
+			c.Filename, c.Name)
+	}
+	c.Filename = abs(c.Filename)
+	return nil
+}
+
+var validContractName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
 // findCfg searches for the config file in this directory and all parents up the tree
 // looking for the closest match
 func findCfg() (string, error) {
@@ -1100,6 +1219,9 @@ func (c *Config) injectBuiltins() {
 			Model: StringList{"github.com/99designs/gqlgen/graphql/introspection.Type"},
 		},
 		"__TypeKind": {Model: StringList{"github.com/99designs/gqlgen/graphql.String"}},
+		"__ErrorBehavior": {
+			Model: StringList{"github.com/99designs/gqlgen/graphql.String"},
+		},
 		"__Field": {
 			Model: StringList{"github.com/99designs/gqlgen/graphql/introspection.Field"},
 		},
@@ -1175,6 +1297,10 @@ func (c *Config) LoadSchema() error {
 		return err
 	}
 
+	if c.OnErrorIntrospection && !slices.Contains(c.Sources, introspection.Prelude) {
+		c.Sources = append(c.Sources, introspection.Prelude)
+	}
+
 	schema, err := gqlparser.LoadSchema(c.Sources...)
 	if err != nil {
 		return err
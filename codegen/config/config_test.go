@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 
@@ -703,3 +704,146 @@ func TestBatchResolverUnsupportedReason(t *testing.T) {
 		})
 	}
 }
+
+func TestInjectCachedDirective(t *testing.T) {
+	load := func(t *testing.T, directive string) *Config {
+		t.Helper()
+
+		cfg := DefaultConfig()
+		cfg.Sources = []*ast.Source{{Input: directive + `
+			type Query { rates: String @cached(ttl: 60) }
+		`}}
+		require.NoError(t, cfg.LoadSchema())
+		require.NoError(t, cfg.injectTypesFromSchema())
+		return cfg
+	}
+
+	t.Run("uses the runtime implementation", func(t *testing.T) {
+		cfg := load(t, `directive @cached(ttl: Int!, key: String) on FIELD_DEFINITION`)
+		require.NotNil(t, cfg.Directives[DirCached].Implementation)
+		require.Equal(t, "graphql.CachedDirective", *cfg.Directives[DirCached].Implementation)
+	})
+
+	t.Run("leaves other declarations alone", func(t *testing.T) {
+		cfg := load(t, `directive @cached(ttl: Int!) on FIELD_DEFINITION`)
+		require.NotContains(t, cfg.Directives, DirCached)
+	})
+}
+
+func TestConfigCheckContracts(t *testing.T) {
+	tests := []struct {
+		name      string
+		contracts []ContractConfig
+		err       string
+	}{
+		{
+			name: "valid contracts",
+			contracts: []ContractConfig{
+				{Name: "Public", Filename: "public.graphql", ExcludeTags: []string{"internal"}},
+				{Name: "Partner", Filename: "partner.graphqls", IncludeTags: []string{"partner"}},
+			},
+		},
+		{
+			name:      "missing name",
+			contracts: []ContractConfig{{Filename: "public.graphql"}},
+			err:       "config.contracts[0]: name is required",
+		},
+		{
+			name:      "invalid name",
+			contracts: []ContractConfig{{Name: "public-api", Filename: "public.graphql"}},
+			err: "config.contracts[0]: name public-api must only contain letters, digits " +
+				"and underscores",
+		},
+		{
+			name:      "missing filename",
+			contracts: []ContractConfig{{Name: "Public"}},
+			err:       "config.contracts[0]: filename is required for contract Public",
+		},
+		{
+			name:      "invalid filename",
+			contracts: []ContractConfig{{Name: "Public", Filename: "public.json"}},
+			err: "config.contracts[0]: filename public.json for contract Public must end " +
+				"with .graphql or .graphqls",
+		},
+		{
+			name: "duplicate name",
+			contracts: []ContractConfig{
+				{Name: "Public", Filename: "public.graphql"},
+				{Name: "Public", Filename: "public2.graphql"},
+			},
+			err: "config.contracts[1]: duplicate contract Public",
+		},
+		{
+			name:      "schema file",
+			contracts: []ContractConfig{{Name: "Public", Filename: "./schema.graphql"}},
+			err:       "config.contracts[0]: schema.graphql is also a schema file",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := Config{
+				SchemaFilename: StringList{"schema.graphql"},
+				Exec:           ExecConfig{Filename: "generated/exec.go"},
+				Model:          PackageConfig{Filename: "generated/models.go"},
+				Contracts:      slices.Clone(tc.contracts),
+			}
+
+			err := config.check()
+			if tc.err == "" {
+				require.NoError(t, err)
+				for i, contract := range config.Contracts {
+					require.Equal(t, abs(tc.contracts[i].Filename), contract.Filename)
+				}
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestInjectSensitiveDirective(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Sources = []*ast.Source{{Input: `
+		directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+		type Query { login(token: String @sensitive): String }
+	`}}
+	require.NoError(t, cfg.LoadSchema())
+	require.NoError(t, cfg.injectTypesFromSchema())
+	require.True(t, cfg.Directives[DirSensitive].SkipRuntime)
+}
+
+func TestInjectStreamDirective(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Sources = []*ast.Source{{Input: `
+		directive @stream(initialCount: Int = 0, label: String, if: Boolean = true) on FIELD
+		type Query { names: [String!]! }
+	`}}
+	require.NoError(t, cfg.LoadSchema())
+	require.NoError(t, cfg.injectTypesFromSchema())
+	require.True(t, cfg.Directives[DirStream].SkipRuntime)
+}
+
+func TestOnErrorIntrospection(t *testing.T) {
+	load := func(t *testing.T, enabled bool) *Config {
+		t.Helper()
+
+		cfg := DefaultConfig()
+		cfg.OnErrorIntrospection = enabled
+		cfg.Sources = []*ast.Source{{Input: `type Query { name: String }`}}
+		require.NoError(t, cfg.LoadSchema())
+		return cfg
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := load(t, false)
+		require.NotContains(t, cfg.Schema.Types, "__ErrorBehavior")
+		require.Nil(t, cfg.Schema.Types["__Schema"].Fields.ForName("defaultErrorBehavior"))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		cfg := load(t, true)
+		require.Contains(t, cfg.Schema.Types, "__ErrorBehavior")
+		require.NotNil(t, cfg.Schema.Types["__Schema"].Fields.ForName("defaultErrorBehavior"))
+	})
+}
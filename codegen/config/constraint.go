@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ConstraintRules returns the Go expressions of the graphql.ConstraintRule values checking the
+// @constraint directive in directives, e.g. `graphql.MinItems(1), graphql.MaxLength(20)`, or ""
+// when there is none.
+func ConstraintRules(directives ast.DirectiveList) (string, error) {
+	dir := directives.ForName(DirConstraint)
+	if dir == nil {
+		return "", nil
+	}
+
+	// the arguments of @constraint, in the order their rules are checked, with the
+	// graphql.ConstraintRule constructor of each
+	constraintArgs := [...]struct{ name, rule string }{
+		{"minItems", "MinItems"},
+		{"maxItems", "MaxItems"},
+		{"minLength", "MinLength"},
+		{"maxLength", "MaxLength"},
+		{"pattern", "Pattern"},
+		{"format", "Format"},
+		{"min", "Min"},
+		{"max", "Max"},
+	}
+
+	values := dir.ArgumentMap(nil)
+	rules := make([]string, 0, len(values))
+	for _, arg := range constraintArgs {
+		var value string
+		switch v := values[arg.name].(type) {
+		case nil:
+			continue
+		case int64:
+			value = strconv.FormatInt(v, 10)
+		case float64:
+			value = strconv.FormatFloat(v, 'g', -1, 64)
+		case string:
+			if arg.name == "pattern" {
+				if _, err := regexp.Compile(v); err != nil {
+					return "", fmt.Errorf("invalid @constraint pattern: %w", err)
+				}
+			}
+			if arg.name == "format" && !graphql.IsConstraintFormat(v) {
+				return "", fmt.Errorf(
+					"invalid @constraint format %q, expected one of email, uri or uuid", v)
+			}
+			value = strconv.Quote(v)
+		default:
+			return "", fmt.Errorf("invalid @constraint %s %v", arg.name, v)
+		}
+		rules = append(rules, fmt.Sprintf("graphql.%s(%s)", arg.rule, value))
+	}
+	return strings.Join(rules, ", "), nil
+}
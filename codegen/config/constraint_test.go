@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestConstraintRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		rules string
+		err   string
+	}{
+		{
+			name:  "no constraint",
+			field: `name: String`,
+		},
+		{
+			name:  "rules in checking order",
+			field: `name: [String!] @constraint(pattern: "^[a-z]+$", maxItems: 3, minLength: 1)`,
+			rules: `graphql.MaxItems(3), graphql.MinLength(1), graphql.Pattern("^[a-z]+$")`,
+		},
+		{
+			name:  "numbers",
+			field: `age: Int @constraint(min: 0, max: 1.5)`,
+			rules: `graphql.Min(0), graphql.Max(1.5)`,
+		},
+		{
+			name:  "invalid pattern",
+			field: `name: String @constraint(pattern: "[a-z")`,
+			err:   "invalid @constraint pattern: error parsing regexp: missing closing ]: `[a-z`",
+		},
+		{
+			name:  "unknown format",
+			field: `name: String @constraint(format: "phone")`,
+			err:   `invalid @constraint format "phone", expected one of email, uri or uuid`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+				directive @constraint(
+					minLength: Int
+					maxLength: Int
+					pattern: String
+					min: Float
+					max: Float
+					format: String
+					minItems: Int
+					maxItems: Int
+				) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+				type Query { noop: Boolean }
+				input Input { ` + tc.field + ` }
+			`})
+
+			rules, err := ConstraintRules(schema.Types["Input"].Fields[0].Directives)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rules, rules)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// IsOneOf reports whether def is an input object marked with @oneOf.
+func IsOneOf(def *ast.Definition) bool {
+	return def != nil && def.Kind == ast.InputObject &&
+		def.Directives.ForName(graphql.OneOfDirective) != nil
+}
+
+// IsOneOfInterface reports whether def is a @oneOf input object that is generated as an
+// interface with one implementation per field, named by OneOfMemberName, which the
+// oneof_interfaces option enables.
+func (c *Config) IsOneOfInterface(def *ast.Definition) bool {
+	return c.OneOfInterfaces && IsOneOf(def)
+}
+
+// OneOfMemberName returns the Go name of the type implementing the @oneOf interface named intf
+// when field is given, e.g. PetInputCat for the field cat of PetInput.
+func OneOfMemberName(intf string, field *ast.FieldDefinition) string {
+	return intf + templates.ToGo(field.Name)
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SemanticNonNullType returns the type of field with the levels marked by @semanticNonNull made
+// non-null, which gqlgen uses for the Go types of the field while keeping field.Type on the wire.
+// It returns field.Type when the field has no @semanticNonNull.
+func SemanticNonNullType(field *ast.FieldDefinition) *ast.Type {
+	levels := graphql.SemanticNonNullLevels(field.Directives)
+	if len(levels) == 0 {
+		return field.Type
+	}
+	return withNonNullLevels(field.Type, levels, 0)
+}
+
+func withNonNullLevels(t *ast.Type, levels []int, level int) *ast.Type {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	c.NonNull = c.NonNull || slices.Contains(levels, level)
+	c.Elem = withNonNullLevels(t.Elem, levels, level+1)
+	return &c
+}
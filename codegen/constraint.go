@@ -0,0 +1,84 @@
+package codegen
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// constraintRules returns the Go expressions of the graphql.ConstraintRule values checking the
+// @constraint directive in directives, or "" when there is none or when @constraint is a user
+// directive run by the resolvers.
+func (b *builder) constraintRules(directives ast.DirectiveList) (string, error) {
+	if !b.Config.Directives[config.DirConstraint].SkipRuntime {
+		return "", nil
+	}
+	return config.ConstraintRules(directives)
+}
+
+// hasConstraints reports whether values of t, or of the fields of the input objects in it, are
+// validated by @constraint.
+func (b *builder) hasConstraints(t *ast.Type, seen map[string]bool) bool {
+	def := b.Schema.Types[t.Name()]
+	if def == nil || def.Kind != ast.InputObject || seen[def.Name] {
+		return false
+	}
+	seen[def.Name] = true
+
+	for _, field := range def.Fields {
+		if rules, _ := b.constraintRules(field.Directives); rules != "" {
+			return true
+		}
+		if b.hasConstraints(field.Type, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// nameConstraintRules sets the names of the package variables holding the @constraint rules of
+// arguments and input fields. Names join GraphQL names with underscores, which GraphQL names can
+// contain too, so a number is appended to the names that are already taken.
+func (d *Data) nameConstraintRules() {
+	taken := map[string]bool{}
+	unique := func(name string) string {
+		candidate := name
+		for i := 2; taken[candidate]; i++ {
+			candidate = fmt.Sprintf("%s%d", name, i)
+		}
+		taken[candidate] = true
+		return candidate
+	}
+
+	args := d.Args()
+	for _, funcName := range slices.Sorted(maps.Keys(args)) {
+		for _, arg := range args[funcName] {
+			if arg.ConstraintRules != "" {
+				arg.ConstraintRulesVar = unique(funcName + "_" + arg.Name + "_constraints")
+			}
+		}
+	}
+	for _, input := range d.Inputs {
+		for _, field := range input.Fields {
+			if field.ConstraintRules != "" {
+				field.ConstraintRulesVar = unique(
+					"unmarshalInput" + input.Name + "_" + field.Name + "_constraints")
+			}
+		}
+	}
+}
+
+// UnmarshalFuncValue returns a Go expression for the unmarshal function of t as a
+// func(context.Context, any) (T, error) value.
+func (d *Data) UnmarshalFuncValue(t *config.TypeReference) string {
+	if d.Config.UseFunctionSyntaxForExecutionContext {
+		return fmt.Sprintf("func(ctx context.Context, v any) (%s, error) { return %s(ctx, ec, v) }",
+			templates.CurrentImports.LookupType(t.GO), t.UnmarshalFunc())
+	}
+	return "ec." + t.UnmarshalFunc()
+}
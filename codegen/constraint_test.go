@@ -0,0 +1,49 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func TestNameConstraintRules(t *testing.T) {
+	inputField := func(obj *Object, name string) *Field {
+		return &Field{
+			FieldDefinition: &ast.FieldDefinition{Name: name},
+			Object:          obj,
+			ConstraintRules: "graphql.MinLength(1)",
+		}
+	}
+	foo := &Object{Definition: &ast.Definition{Name: "Foo"}}
+	foo.Fields = []*Field{inputField(foo, "bar_baz")}
+	fooBar := &Object{Definition: &ast.Definition{Name: "Foo_bar"}}
+	fooBar.Fields = []*Field{inputField(fooBar, "baz")}
+
+	query := &Object{Definition: &ast.Definition{Name: "Query"}}
+	arg := &FieldArgument{
+		ArgumentDefinition: &ast.ArgumentDefinition{Name: "name"},
+		ConstraintRules:    "graphql.MinLength(1)",
+	}
+	unconstrained := &FieldArgument{ArgumentDefinition: &ast.ArgumentDefinition{Name: "id"}}
+	query.Fields = []*Field{{
+		FieldDefinition: &ast.FieldDefinition{Name: "users"},
+		Object:          query,
+		Args:            []*FieldArgument{arg, unconstrained},
+	}}
+
+	d := &Data{
+		Config:  &config.Config{},
+		Objects: Objects{query},
+		Inputs:  Objects{foo, fooBar},
+	}
+	d.nameConstraintRules()
+
+	require.Equal(t, "field_Query_users_args_name_constraints", arg.ConstraintRulesVar)
+	require.Empty(t, unconstrained.ConstraintRulesVar)
+	require.Equal(t, "unmarshalInputFoo_bar_baz_constraints", foo.Fields[0].ConstraintRulesVar)
+	require.Equal(t, "unmarshalInputFoo_bar_baz_constraints2", fooBar.Fields[0].ConstraintRulesVar,
+		"names that are already taken are numbered")
+}
@@ -0,0 +1,27 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// generateContracts writes the SDL of every schema contract to its file.
+func generateContracts(data *Data) error {
+	for _, contract := range data.Config.Contracts {
+		schema := graphql.ContractSchema(data.Schema, contract.IncludeTags, contract.ExcludeTags)
+
+		var buf bytes.Buffer
+		buf.WriteString("# Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\n")
+		formatter.NewFormatter(&buf).FormatSchema(schema)
+
+		if err := os.WriteFile(contract.Filename, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("unable to write contract %s: %w", contract.Name, err)
+		}
+	}
+	return nil
+}
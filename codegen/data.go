@@ -421,6 +421,8 @@ func BuildData(cfg *config.Config, plugins ...any) (*Data, error) {
 		return s.Inputs[i].Name < s.Inputs[j].Name
 	})
 
+	s.nameConstraintRules()
+
 	if b.Binder.SawInvalid {
 		// if we have a syntax error, show it
 		err := cfg.Packages.Errors()
@@ -17,6 +17,7 @@ import (
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/internal/code"
 )
 
@@ -41,6 +42,23 @@ type Field struct {
 	HasHaser         bool   // Whether a haser method is available (e.g., HasName())
 	HaserMethodName  string // Name of the haser method
 	Batch            bool   // Enable batch resolver for this field
+	// OneOfType is the type implementing the interface of a @oneOf input object that holds the
+	// value of this field, which the field is bound to instead of the interface.
+	OneOfType types.Type
+	// ConstraintRules are the Go expressions of the rules validating an input field, from its
+	// @constraint directive
+	ConstraintRules string
+	// ConstraintRulesVar is the name of the package variable holding ConstraintRules
+	ConstraintRulesVar string
+	// StreamSource is how the resolver of a list field returns its items, from the streamSource
+	// config: "seq" for an iter.Seq, "chan" for a channel, or "" for a slice
+	StreamSource string
+	// Streamable reports whether the items of this list field can be streamed with @stream
+	Streamable bool
+	// SemanticNonNullLevels are the levels of the field type marked as non-null by
+	// @semanticNonNull, set on the FieldContext of the field so that execution does not look
+	// the directive up
+	SemanticNonNullLevels []int
 	// SubscriptionContextField mirrors the global subscription_context_field config
 	// option, resolved once at build time so UsesSubscriptionContext and the methods
 	// that depend on it stay nullary instead of threading the flag through the call chain.
@@ -61,6 +79,7 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		GoFieldType:              GoFieldVariable,
 		GoReceiverName:           "obj",
 		SubscriptionContextField: b.Config.SubscriptionContextField,
+		SemanticNonNullLevels:    graphql.SemanticNonNullLevels(field.Directives),
 	}
 
 	if field.DefaultValue != nil {
@@ -71,6 +90,13 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		}
 	}
 
+	if obj.Kind == ast.InputObject {
+		f.ConstraintRules, err = b.constraintRules(field.Directives)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+	}
+
 	for _, arg := range field.Arguments {
 		newArg, err := b.buildArg(obj, arg)
 		if err != nil {
@@ -79,6 +105,12 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		f.Args = append(f.Args, newArg)
 	}
 
+	if obj.IsOneOf() {
+		if f.OneOfType, err = b.findOneOfType(obj, field); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = b.bindField(obj, &f); err != nil {
 		f.IsResolver = true
 		if errors.Is(err, config.ErrTypeNotFound) {
@@ -120,9 +152,41 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		f.TypeReference = b.Binder.PointerTo(f.TypeReference)
 	}
 
+	if err := b.bindStreamSource(obj, &f); err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+	}
+
 	return &f, nil
 }
 
+// bindStreamSource sets whether the items of f can be streamed with @stream, which needs the
+// schema to declare the directive, and how its resolver returns them.
+func (b *builder) bindStreamSource(obj *Object, f *Field) error {
+	if fieldCfg, ok := b.Config.Models[obj.Name]; ok {
+		f.StreamSource = fieldCfg.Fields[f.Name].StreamSource
+	}
+	listField := f.TypeReference.IsSlice() && obj.Kind == ast.Object && !obj.Stream &&
+		!obj.BuiltIn
+
+	switch f.StreamSource {
+	case "":
+		f.Streamable = listField && b.Schema.Directives[config.DirStream] != nil
+		return nil
+	case "seq", "chan":
+	default:
+		return fmt.Errorf("streamSource must be seq or chan, not %q", f.StreamSource)
+	}
+	if !listField {
+		return errors.New("streamSource is only supported on list fields outside of subscriptions")
+	}
+	if f.Batch {
+		return errors.New("streamSource is not supported with batch resolvers")
+	}
+	f.IsResolver = true
+	f.Streamable = true
+	return nil
+}
+
 func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	defer func() {
 		if f.TypeReference == nil {
@@ -130,6 +194,11 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 			if err != nil {
 				errret = err
 			}
+			// resolvers of @semanticNonNull fields return non-null Go types
+			if semantic := config.SemanticNonNullType(f.FieldDefinition); tr != nil &&
+				semantic != f.Type && tr.Target != nil {
+				tr.GO = b.Binder.CopyModifiersFromAst(semantic, tr.Target)
+			}
 			f.TypeReference = tr
 		}
 		if f.TypeReference != nil {
@@ -196,20 +265,25 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	// Check for protobuf-style haser method (only if enabled and field is nullable)
 	// Use the original field name, not the bound method/field name
 	// (e.g., for field "name" bound to "GetName()", look for "HasName" not "HasGetName")
+	bindType := obj.Type
+	if f.OneOfType != nil {
+		bindType = f.OneOfType
+	}
+
 	autoBindGetterHaser := b.Config.AutobindGetterHaser
 	if val := b.Config.Models[obj.Name].Fields[f.Name].AutoBindGetterHaser; val != nil {
 		autoBindGetterHaser = *val
 	}
 
 	if autoBindGetterHaser && !f.Type.NonNull {
-		haser, _ := b.findBindHaserMethod(obj.Type, f.GoFieldName)
+		haser, _ := b.findBindHaserMethod(bindType, f.GoFieldName)
 		if haser != nil {
 			f.HasHaser = true
 			f.HaserMethodName = haser.Name()
 		}
 	}
 
-	target, err := b.findBindTarget(obj.Type, f.GoFieldName, autoBindGetterHaser)
+	target, err := b.findBindTarget(bindType, f.GoFieldName, autoBindGetterHaser)
 	if err != nil {
 		return err
 	}
@@ -223,7 +297,7 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 			return nil
 		}
 
-		objPos := b.Binder.TypePosition(obj.Type)
+		objPos := b.Binder.TypePosition(bindType)
 		return fmt.Errorf(
 			"%s:%d adding resolver method for %s.%s, nothing matched",
 			objPos.Filename,
@@ -292,6 +366,26 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	}
 }
 
+// findOneOfType returns the type implementing the interface bound to the @oneOf input object obj
+// for field, which is declared next to the interface and named by config.OneOfMemberName.
+func (b *builder) findOneOfType(obj *Object, field *ast.FieldDefinition) (types.Type, error) {
+	intf, ok := obj.Type.(*types.Named)
+	if !ok || intf.Obj().Pkg() == nil {
+		return nil, fmt.Errorf("%s: @oneOf input must be bound to a named interface", obj.Name)
+	}
+
+	name := config.OneOfMemberName(intf.Obj().Name(), field)
+	member, err := b.Binder.FindObject(intf.Obj().Pkg().Path(), name)
+	if err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+	}
+	if !types.Implements(member.Type(), intf.Underlying().(*types.Interface)) {
+		return nil, fmt.Errorf("%s.%s: %s does not implement %s", obj.Name, field.Name,
+			member.Type().String(), intf.String())
+	}
+	return member.Type(), nil
+}
+
 // findBindTarget attempts to match the name to a field or method on a Type
 // with the following priorities:
 // 1. Any Fields with a struct tag (see config.StructTag). Errors if more than one match is found
@@ -614,9 +708,13 @@ func (f *Field) MarshalerReturnType() string {
 
 // ResolveFieldFunc returns the name of the graphql runtime helper the generated
 // executor calls to resolve this field: ResolveField for normal fields,
+// ResolveFieldList for list fields that can be streamed with @stream,
 // ResolveFieldStream for subscription streams, and
 // ResolveFieldStreamWithEventContext for streams annotated @subscriptionContext.
 func (f *Field) ResolveFieldFunc() string {
+	if f.Streamable {
+		return "ResolveFieldList"
+	}
 	if !f.Object.Stream {
 		return "ResolveField"
 	}
@@ -671,6 +769,28 @@ func (f *Field) IsConcurrent() bool {
 	return f.MethodHasContext || f.IsResolver
 }
 
+// streamSourceType returns the Go type returned by the resolver of a field with a streamSource.
+func (f *Field) streamSourceType() string {
+	elem := templates.CurrentImports.LookupType(f.TypeReference.GO.(*types.Slice).Elem())
+	if f.StreamSource == "chan" {
+		return "<-chan " + elem
+	}
+	return templates.CurrentImports.Lookup("iter") + ".Seq[" + elem + "]"
+}
+
+// StreamItemsFunc returns the graphql function listing the items returned by the resolver of a
+// streamable list field.
+func (f *Field) StreamItemsFunc() string {
+	elem := templates.CurrentImports.LookupType(f.TypeReference.GO.(*types.Slice).Elem())
+	switch f.StreamSource {
+	case "seq":
+		return "graphql.SeqItems[" + elem + "]"
+	case "chan":
+		return "graphql.ChanItems[" + elem + "]"
+	}
+	return "graphql.SliceItems[" + elem + "]"
+}
+
 // IsBatch returns true if this field has batch resolver enabled.
 func (f *Field) IsBatch() bool {
 	return f.Batch
@@ -897,6 +1017,9 @@ func (f *Field) ShortResolverSignature(ft *goast.FuncType) string {
 	res += resSb540.String()
 
 	result := templates.CurrentImports.LookupType(f.TypeReference.GO)
+	if f.StreamSource != "" {
+		result = f.streamSourceType()
+	}
 	if f.Object.Stream {
 		if f.UsesSubscriptionContext() {
 			gqlPkg := templates.CurrentImports.Lookup("github.com/99designs/gqlgen/graphql")
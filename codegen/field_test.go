@@ -49,6 +49,15 @@ func TestField_StreamResolverShape(t *testing.T) {
 			wantReturnType: "func(ctx context.Context) (context.Context, graphql.Marshaler)",
 			wantResolveFn:  "ResolveFieldStreamWithEventContext",
 		},
+		"list field streamable with @stream": {
+			field: func() *Field {
+				f := newField(false, false)
+				f.Streamable = true
+				return f
+			}(),
+			wantReturnType: "graphql.Marshaler",
+			wantResolveFn:  "ResolveFieldList",
+		},
 	}
 
 	for name, tt := range tests {
@@ -713,3 +722,83 @@ func TestField_ChildFieldContextTypeName(t *testing.T) {
 		require.Empty(t, f.ChildFieldContextTypeName())
 	})
 }
+
+func TestField_StreamSource(t *testing.T) {
+	buildQuery := func(
+		t *testing.T,
+		schema string,
+		fields map[string]config.TypeMapField,
+	) (*Object, error) {
+		t.Helper()
+		models := config.TypeMap{"Query": {Fields: fields}}
+		for _, name := range []string{"Boolean", "Float", "ID", "Int", "String"} {
+			models.Add(name, "github.com/99designs/gqlgen/graphql."+name)
+		}
+		cfg := &config.Config{
+			Exec: config.ExecConfig{
+				Layout:   config.ExecLayoutSingleFile,
+				Filename: "generated.go",
+				Package:  "generated",
+			},
+			Models:     models,
+			Directives: map[string]config.DirectiveConfig{},
+			Packages:   code.NewPackages(),
+		}
+		cfg.Schema = gqlparser.MustLoadSchema(&ast2.Source{Name: "schema.graphql", Input: schema})
+
+		b := builder{
+			Config: cfg,
+			Schema: cfg.Schema,
+		}
+		b.Binder = b.Config.NewBinder()
+		var err error
+		b.Directives, err = b.buildDirectives()
+		require.NoError(t, err)
+
+		return b.buildObject(cfg.Schema.Query)
+	}
+	const schema = `type Query { names: [String!]! version: String }`
+
+	t.Run("list fields are streamable when @stream is declared", func(t *testing.T) {
+		obj, err := buildQuery(t, `
+			directive @stream(initialCount: Int = 0, label: String, if: Boolean = true) on FIELD
+		`+schema, nil)
+		require.NoError(t, err)
+		require.True(t, obj.Fields[0].Streamable)
+		require.False(t, obj.Fields[1].Streamable)
+	})
+
+	t.Run("list fields are not streamable without @stream", func(t *testing.T) {
+		obj, err := buildQuery(t, schema, nil)
+		require.NoError(t, err)
+		require.False(t, obj.Fields[0].Streamable)
+	})
+
+	t.Run("streamSource makes a streamable resolver", func(t *testing.T) {
+		obj, err := buildQuery(t, schema, map[string]config.TypeMapField{
+			"names": {StreamSource: "seq"},
+		})
+		require.NoError(t, err)
+		require.True(t, obj.Fields[0].Streamable)
+		require.True(t, obj.Fields[0].IsResolver)
+		require.Equal(t, "graphql.SeqItems[string]", obj.Fields[0].StreamItemsFunc())
+	})
+
+	t.Run("streamSource must be seq or chan", func(t *testing.T) {
+		_, err := buildQuery(t, schema, map[string]config.TypeMapField{
+			"names": {StreamSource: "slice"},
+		})
+		require.EqualError(t, err, `Query.names: streamSource must be seq or chan, not "slice"`)
+	})
+
+	t.Run("streamSource is rejected on fields that are not lists", func(t *testing.T) {
+		_, err := buildQuery(t, schema, map[string]config.TypeMapField{
+			"version": {StreamSource: "chan"},
+		})
+		require.EqualError(
+			t,
+			err,
+			"Query.version: streamSource is only supported on list fields outside of subscriptions",
+		)
+	})
+}
@@ -27,6 +27,10 @@ func GenerateCode(data *Data) error {
 		return errors.New("missing exec config")
 	}
 
+	if err := generateContracts(data); err != nil {
+		return err
+	}
+
 	switch data.Config.Exec.Layout {
 	case config.ExecLayoutSingleFile:
 		return generateSingleFile(data)
@@ -3,9 +3,12 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql/introspection"
 )
 
 // InlineArgsInfo stores metadata about arguments that were inlined.
@@ -146,11 +149,16 @@ func SerializeTransformedSchema(
 	f := formatter.NewFormatter(&buf)
 	f.FormatSchema(schema)
 
-	return []*ast.Source{
+	sources := []*ast.Source{
 		{
 			Name:    "inline_arguments_transformed_schema.graphql",
 			Input:   buf.String(),
 			BuiltIn: true,
 		},
-	}, nil
+	}
+	// the formatter skips built-in definitions, so extensions of introspection types are lost
+	if slices.Contains(originalSources, introspection.Prelude) {
+		sources = append(sources, introspection.Prelude)
+	}
+	return sources, nil
 }
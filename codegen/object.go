@@ -64,6 +64,10 @@ func (b *builder) buildObject(typ *ast.Definition) (*Object, error) {
 		}
 		obj.Type = goObject
 	}
+	if obj.IsOneOf() {
+		// @oneOf inputs unmarshal to an interface, which is never returned as a pointer
+		obj.PointersInUnmarshalInput = false
+	}
 
 	for _, intf := range b.Schema.GetImplements(typ) {
 		obj.Implements = append(obj.Implements, b.Schema.Types[intf.Name])
@@ -174,6 +178,12 @@ func (o *Object) InputObjectDirectives() []*Directive {
 	return d
 }
 
+// IsOneOf reports whether the object is a @oneOf input object bound to an interface. Its fields
+// are bound to the types implementing that interface, and only one of them is unmarshaled.
+func (o *Object) IsOneOf() bool {
+	return config.IsOneOf(o.Definition) && o.Type != nil && types.IsInterface(o.Type)
+}
+
 func (o *Object) IsConcurrent() bool {
 	for _, f := range o.Fields {
 		if f.IsConcurrent() {
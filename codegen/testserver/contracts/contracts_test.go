@@ -0,0 +1,90 @@
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen.yml -stub stub.go
+
+package contracts
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestContracts(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Users = func(ctx context.Context) ([]*User, error) {
+		return []*User{{ID: "1", Name: "bob", Email: "bob@example.com"}}, nil
+	}
+	resolvers.QueryResolver.Audit = func(ctx context.Context) ([]*AuditLog, error) {
+		return []*AuditLog{{ID: "1", Message: "bob signed in"}}, nil
+	}
+
+	newClient := func(es func(Config) graphql.ExecutableSchema) *client.Client {
+		srv := handler.New(es(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		srv.Use(extension.Introspection{})
+		return client.New(srv)
+	}
+
+	t.Run("full schema", func(t *testing.T) {
+		c := newClient(NewExecutableSchema)
+
+		var resp struct {
+			Users []struct{ Name, Email string }
+			Audit []struct{ Message string }
+		}
+		c.MustPost(`{ users { name email } audit { message } }`, &resp)
+		require.Equal(t, "bob@example.com", resp.Users[0].Email)
+		require.Equal(t, "bob signed in", resp.Audit[0].Message)
+	})
+
+	t.Run("public contract", func(t *testing.T) {
+		c := newClient(NewPublicExecutableSchema)
+
+		var resp struct {
+			Users []struct{ Name string }
+		}
+		c.MustPost(`{ users { name } }`, &resp)
+		require.Equal(t, "bob", resp.Users[0].Name)
+
+		err := c.Post(`{ users { email } }`, &resp)
+		require.EqualError(t, err,
+			`http 422: {"errors":[{"message":"Cannot query field \"email\" on type \"User\".","locations":[{"line":1,"column":11}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+
+		err = c.Post(`{ audit { message } }`, &resp)
+		require.EqualError(t, err,
+			`http 422: {"errors":[{"message":"Cannot query field \"audit\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+
+		var introspection struct {
+			Schema struct {
+				Types []struct{ Name string }
+			} `json:"__schema"`
+		}
+		c.MustPost(`{ __schema { types { name } } }`, &introspection)
+		for _, typ := range introspection.Schema.Types {
+			require.NotEqual(t, "AuditLog", typ.Name)
+		}
+	})
+
+	t.Run("public contract SDL", func(t *testing.T) {
+		sdl, err := os.ReadFile("public.graphql")
+		require.NoError(t, err)
+		require.Equal(t, `# Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | ENUM_VALUE | ARGUMENT_DEFINITION
+type Query {
+	users: [User!]! @tag(name: "public")
+}
+type User {
+	id: ID! @tag(name: "public")
+	name: String! @tag(name: "public")
+}
+`, string(sdl))
+	})
+}
@@ -0,0 +1,17 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package contracts
+
+type AuditLog struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
@@ -0,0 +1,27 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package contracts
+
+import (
+	"context"
+)
+
+type Stub struct {
+	QueryResolver struct {
+		Users func(ctx context.Context) ([]*User, error)
+		Audit func(ctx context.Context) ([]*AuditLog, error)
+	}
+}
+
+func (r *Stub) Query() QueryResolver {
+	return &stubQuery{r}
+}
+
+type stubQuery struct{ *Stub }
+
+func (r *stubQuery) Users(ctx context.Context) ([]*User, error) {
+	return r.QueryResolver.Users(ctx)
+}
+func (r *stubQuery) Audit(ctx context.Context) ([]*AuditLog, error) {
+	return r.QueryResolver.Audit(ctx)
+}
@@ -74,7 +74,7 @@ func (ec *executionContext) _Map(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("Map")
 		case "id":
 			out.Values[i] = ec._Map_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -0,0 +1,81 @@
+package followschema
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestCached(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	call := func(key string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[key]++
+		return calls[key]
+	}
+	count := func(key string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[key]
+	}
+
+	resolvers := &Stub{}
+	resolvers.QueryResolver.CachedRate = func(ctx context.Context, currency string) (float64, error) {
+		return float64(call(currency)), nil
+	}
+	resolvers.QueryResolver.CachedUsers = func(ctx context.Context) ([]*CachedUser, error) {
+		return []*CachedUser{{ID: "1"}, {ID: "2"}, {ID: "1"}}, nil
+	}
+	resolvers.CachedUserResolver.Score = func(ctx context.Context, obj *CachedUser) (int, error) {
+		return call("user" + obj.ID), nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(&extension.FieldCache{Cache: lru.New[*extension.FieldCacheEntry](100)})
+	c := client.New(srv)
+
+	t.Run("memoizes results", func(t *testing.T) {
+		var resp struct{ CachedRate float64 }
+		c.MustPost(`{ cachedRate(currency: "EUR") }`, &resp)
+		require.InDelta(t, 1, resp.CachedRate, 0)
+		c.MustPost(`{ cachedRate(currency: "EUR") }`, &resp)
+		require.InDelta(t, 1, resp.CachedRate, 0)
+		require.Equal(t, 1, count("EUR"))
+	})
+
+	t.Run("arguments are part of the key", func(t *testing.T) {
+		var resp struct{ CachedRate float64 }
+		c.MustPost(`{ cachedRate(currency: "USD") }`, &resp)
+		require.InDelta(t, 1, resp.CachedRate, 0)
+		require.Equal(t, 1, count("USD"))
+		require.Equal(t, 1, count("EUR"))
+	})
+
+	t.Run("key fields are part of the key", func(t *testing.T) {
+		var resp struct {
+			CachedUsers []struct {
+				ID    string
+				Score int
+			}
+		}
+		c.MustPost(`{ cachedUsers { id score } }`, &resp)
+		c.MustPost(`{ cachedUsers { id score } }`, &resp)
+		require.Len(t, resp.CachedUsers, 3)
+		for _, user := range resp.CachedUsers {
+			require.Equal(t, 1, user.Score)
+		}
+		require.Equal(t, 1, count("user1"))
+		require.Equal(t, 1, count("user2"))
+	})
+}
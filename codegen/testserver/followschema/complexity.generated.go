@@ -178,12 +178,12 @@ func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.Sele
 			out.Values[i] = graphql.MarshalString("OverlappingFields")
 		case "oneFoo":
 			out.Values[i] = ec._OverlappingFields_oneFoo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "twoFoo":
 			out.Values[i] = ec._OverlappingFields_twoFoo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "oldFoo":
@@ -196,7 +196,7 @@ func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.Sele
 					}
 				}()
 				res = ec._OverlappingFields_oldFoo(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -226,12 +226,12 @@ func (ec *executionContext) _OverlappingFields(ctx context.Context, sel ast.Sele
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "newFoo":
 			out.Values[i] = ec._OverlappingFields_newFoo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "new_foo":
 			out.Values[i] = ec._OverlappingFields_new_foo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		default:
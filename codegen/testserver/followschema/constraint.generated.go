@@ -0,0 +1,165 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    **************************** field.gotpl *****************************
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+var unmarshalInputConstrainedItemInput_name_constraints = []graphql.ConstraintRule{graphql.MinLength(1), graphql.Pattern("^[A-Za-z ]+$")}
+var unmarshalInputConstrainedItemInput_quantity_constraints = []graphql.ConstraintRule{graphql.Min(1), graphql.Max(100)}
+
+func (ec *executionContext) unmarshalInputConstrainedItemInput(ctx context.Context, obj any) (ConstrainedItemInput, error) {
+	var it ConstrainedItemInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "quantity"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalNString2string, unmarshalInputConstrainedItemInput_name_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalNInt2int, unmarshalInputConstrainedItemInput_quantity_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		}
+	}
+	return it, nil
+}
+
+var unmarshalInputConstrainedOrderInput_email_constraints = []graphql.ConstraintRule{graphql.Format("email")}
+var unmarshalInputConstrainedOrderInput_website_constraints = []graphql.ConstraintRule{graphql.Format("uri")}
+var unmarshalInputConstrainedOrderInput_reference_constraints = []graphql.ConstraintRule{graphql.Format("uuid")}
+var unmarshalInputConstrainedOrderInput_items_constraints = []graphql.ConstraintRule{graphql.MinItems(1), graphql.MaxItems(3)}
+var unmarshalInputConstrainedOrderInput_tags_constraints = []graphql.ConstraintRule{graphql.MaxItems(2), graphql.Pattern("^[a-z]+$")}
+
+func (ec *executionContext) unmarshalInputConstrainedOrderInput(ctx context.Context, obj any) (ConstrainedOrderInput, error) {
+	var it ConstrainedOrderInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"email", "website", "reference", "items", "tags"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalNString2string, unmarshalInputConstrainedOrderInput_email_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "website":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("website"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalOString2ᚖstring, unmarshalInputConstrainedOrderInput_website_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Website = data
+		case "reference":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reference"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalOID2ᚖstring, unmarshalInputConstrainedOrderInput_reference_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Reference = data
+		case "items":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("items"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalNConstrainedItemInput2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedItemInputᚄ, unmarshalInputConstrainedOrderInput_items_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Items = data
+		case "tags":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			data, err := graphql.UnmarshalConstrained(ctx, v, ec.unmarshalOString2ᚕstringᚄ, unmarshalInputConstrainedOrderInput_tags_constraints...)
+			if err != nil {
+				return it, err
+			}
+			it.Tags = data
+		}
+	}
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNConstrainedItemInput2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedItemInputᚄ(ctx context.Context, v any) ([]*ConstrainedItemInput, error) {
+	vSlice := graphql.CoerceList(v)
+	var err error
+	res := make([]*ConstrainedItemInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNConstrainedItemInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedItemInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNConstrainedItemInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedItemInput(ctx context.Context, v any) (*ConstrainedItemInput, error) {
+	res, err := ec.unmarshalInputConstrainedItemInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNConstrainedOrderInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐConstrainedOrderInput(ctx context.Context, v any) (ConstrainedOrderInput, error) {
+	res, err := ec.unmarshalInputConstrainedOrderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+// endregion ***************************** type.gotpl *****************************
@@ -105,9 +105,10 @@ func (ec *executionContext) fieldContext_DeferModel_otherResolvedValue(_ context
 }
 
 func (ec *executionContext) _DeferModel_values(ctx context.Context, field graphql.CollectedField, obj *DeferModel) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_DeferModel_values(ctx, field)
@@ -121,6 +122,11 @@ func (ec *executionContext) _DeferModel_values(ctx context.Context, field graphq
 		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
 			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
 		},
+		graphql.SliceItems[string],
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
 		true,
 		true,
 	)
@@ -175,12 +181,12 @@ func (ec *executionContext) _DeferModel(ctx context.Context, sel ast.SelectionSe
 			out.Values[i] = graphql.MarshalString("DeferModel")
 		case "id":
 			out.Values[i] = ec._DeferModel_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "name":
 			out.Values[i] = ec._DeferModel_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "otherResolvedValue":
@@ -193,7 +199,7 @@ func (ec *executionContext) _DeferModel(ctx context.Context, sel ast.SelectionSe
 					}
 				}()
 				res = ec._DeferModel_otherResolvedValue(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -231,7 +237,7 @@ func (ec *executionContext) _DeferModel(ctx context.Context, sel ast.SelectionSe
 					}
 				}()
 				res = ec._DeferModel_values(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -305,7 +311,7 @@ func (ec *executionContext) marshalODeferModel2ᚕᚖgithubᚗcomᚋ99designsᚋ
 	})
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
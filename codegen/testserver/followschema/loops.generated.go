@@ -116,7 +116,7 @@ func (ec *executionContext) _LoopA(ctx context.Context, sel ast.SelectionSet, ob
 			out.Values[i] = graphql.MarshalString("LoopA")
 		case "b":
 			out.Values[i] = ec._LoopA_b(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -154,7 +154,7 @@ func (ec *executionContext) _LoopB(ctx context.Context, sel ast.SelectionSet, ob
 			out.Values[i] = graphql.MarshalString("LoopB")
 		case "a":
 			out.Values[i] = ec._LoopB_a(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
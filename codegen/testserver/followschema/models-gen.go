@@ -35,6 +35,10 @@ type Mammalian interface {
 	GetSize() *Size
 }
 
+type OneOfPetInput interface {
+	isOneOfPetInput()
+}
+
 type TestUnion interface {
 	IsTestUnion()
 }
@@ -59,6 +63,11 @@ type B struct {
 
 func (B) IsTestUnion() {}
 
+type CachedUser struct {
+	ID    string `json:"id"`
+	Score int    `json:"score"`
+}
+
 type Cat struct {
 	Species  string `json:"species"`
 	Size     *Size  `json:"size"`
@@ -73,6 +82,19 @@ type CheckIssue896 struct {
 	ID *int `json:"id,omitempty"`
 }
 
+type ConstrainedItemInput struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+type ConstrainedOrderInput struct {
+	Email     string                  `json:"email"`
+	Website   *string                 `json:"website,omitempty"`
+	Reference *string                 `json:"reference,omitempty"`
+	Items     []*ConstrainedItemInput `json:"items"`
+	Tags      []string                `json:"tags,omitempty"`
+}
+
 type ContentPost struct {
 	Foo *string `json:"foo,omitempty"`
 }
@@ -220,6 +242,34 @@ type OmittableInput struct {
 	Object graphql.Omittable[*OuterInput] `json:"object,omitempty"`
 }
 
+type OneOfCatInput struct {
+	Name  string `json:"name"`
+	Lives int    `json:"lives"`
+}
+
+type OneOfOwnerInput struct {
+	Name string        `json:"name"`
+	Pet  OneOfPetInput `json:"pet,omitempty"`
+}
+
+type OneOfPetInputCat struct {
+	Cat *OneOfCatInput `json:"cat"`
+}
+
+func (OneOfPetInputCat) isOneOfPetInput() {}
+
+type OneOfPetInputDog struct {
+	Dog string `json:"dog"`
+}
+
+func (OneOfPetInputDog) isOneOfPetInput() {}
+
+type OneOfPetInputTags struct {
+	Tags []string `json:"tags"`
+}
+
+func (OneOfPetInputTags) isOneOfPetInput() {}
+
 type OuterInput struct {
 	Inner *InnerInput `json:"inner"`
 }
@@ -240,6 +290,13 @@ type Pet struct {
 type Query struct {
 }
 
+type SemanticNonNull struct {
+	Name    string             `json:"name"`
+	Friend  *SemanticNonNull   `json:"friend"`
+	Tags    []string           `json:"tags"`
+	Friends []*SemanticNonNull `json:"friends,omitempty"`
+}
+
 type Size struct {
 	Height int `json:"height"`
 	Weight int `json:"weight"`
@@ -261,6 +318,11 @@ type SpecialInput struct {
 	Nesting *NestedInput `json:"nesting"`
 }
 
+type StreamItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type Subscription struct {
 }
 
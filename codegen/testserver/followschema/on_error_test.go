@@ -0,0 +1,125 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestOnError(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Valid = func(ctx context.Context) (string, error) {
+		return "Ok", nil
+	}
+	resolvers.QueryResolver.ErrorBubble = func(ctx context.Context) (*Error, error) {
+		return &Error{ID: "E1234"}, nil
+	}
+	resolvers.QueryResolver.ErrorBubbleList = func(ctx context.Context) ([]*Error, error) {
+		return []*Error{{ID: "1"}, nil}, nil
+	}
+	resolvers.QueryResolver.Invalid = func(ctx context.Context) (string, error) {
+		return "", errors.New("ERROR")
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	onError := func(behavior graphql.ErrorBehavior) client.Option {
+		return client.Extensions(map[string]any{"onError": behavior})
+	}
+
+	t.Run("PROPAGATE bubbles nulls to the closest nullable parent", func(t *testing.T) {
+		resp, err := c.RawPost(`{ valid errorBubble { id errorOnRequiredField } }`,
+			onError(graphql.ErrorBehaviorPropagate))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"valid": "Ok", "errorBubble": nil}, resp.Data)
+		require.Contains(t, string(resp.Errors), `"path":["errorBubble","errorOnRequiredField"]`)
+	})
+
+	t.Run("NULL keeps the null at the failing field", func(t *testing.T) {
+		resp, err := c.RawPost(`{ valid errorBubble { id errorOnRequiredField } }`,
+			onError(graphql.ErrorBehaviorNull))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"valid":       "Ok",
+			"errorBubble": map[string]any{"id": "E1234", "errorOnRequiredField": nil},
+		}, resp.Data)
+		require.Contains(t, string(resp.Errors), `"path":["errorBubble","errorOnRequiredField"]`)
+	})
+
+	t.Run("NULL keeps the null of non-null list items", func(t *testing.T) {
+		resp, err := c.RawPost(`{ errorBubbleList { id } }`, onError(graphql.ErrorBehaviorNull))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"errorBubbleList": []any{map[string]any{"id": "1"}, nil},
+		}, resp.Data)
+		require.Contains(t, string(resp.Errors), `"path":["errorBubbleList",1]`)
+	})
+
+	t.Run("NULL keeps the null of non-null root fields", func(t *testing.T) {
+		resp, err := c.RawPost(`{ valid invalid }`, onError(graphql.ErrorBehaviorNull))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"valid": "Ok", "invalid": nil}, resp.Data)
+	})
+
+	t.Run("HALT aborts the response at the first error", func(t *testing.T) {
+		resp, err := c.RawPost(
+			`{ valid errorBubble { id errorOnNonRequiredField errorOnRequiredField } }`,
+			onError(graphql.ErrorBehaviorHalt))
+		require.NoError(t, err)
+		require.Nil(t, resp.Data)
+
+		var errs []map[string]any
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, "boom", errs[0]["message"])
+	})
+
+	t.Run("HALT keeps responses without errors", func(t *testing.T) {
+		resp, err := c.RawPost(`{ valid }`, onError(graphql.ErrorBehaviorHalt))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"valid": "Ok"}, resp.Data)
+	})
+
+	t.Run("the server default applies without onError", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		srv.Use(extension.Introspection{})
+		srv.SetDefaultErrorBehavior(graphql.ErrorBehaviorNull)
+		c := client.New(srv)
+
+		resp, err := c.RawPost(`{ errorBubble { id errorOnRequiredField } }`)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"errorBubble": map[string]any{"id": "E1234", "errorOnRequiredField": nil},
+		}, resp.Data)
+
+		var introspection struct {
+			Schema struct {
+				DefaultErrorBehavior string
+			} `json:"__schema"`
+		}
+		c.MustPost(`{ __schema { defaultErrorBehavior } }`, &introspection,
+			onError(graphql.ErrorBehaviorHalt))
+		require.Equal(t, "NULL", introspection.Schema.DefaultErrorBehavior)
+	})
+
+	t.Run("rejects unknown behaviors", func(t *testing.T) {
+		var resp any
+		err := c.Post(`{ valid }`, &resp, onError("IGNORE"))
+		require.ErrorContains(t, err,
+			"http 422: "+
+				`{"errors":[{"message":"invalid onError value IGNORE, expected one of NULL, `+
+				`PROPAGATE or HALT","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}]`)
+	})
+}
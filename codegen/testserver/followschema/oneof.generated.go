@@ -0,0 +1,191 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    **************************** field.gotpl *****************************
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputOneOfCatInput(ctx context.Context, obj any) (OneOfCatInput, error) {
+	var it OneOfCatInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "lives"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "lives":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lives"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Lives = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOneOfOwnerInput(ctx context.Context, obj any) (OneOfOwnerInput, error) {
+	var it OneOfOwnerInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "pet"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "pet":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pet"))
+			data, err := ec.unmarshalOOneOfPetInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfPetInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pet = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOneOfPetInput(ctx context.Context, obj any) (OneOfPetInput, error) {
+	var it OneOfPetInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if err := graphql.ValidateOneOf(ctx, "OneOfPetInput", asMap); err != nil {
+		return it, err
+	}
+
+	fieldsInOrder := [...]string{"cat", "dog", "tags"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "cat":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cat"))
+			var member OneOfPetInputCat
+			data, err := ec.unmarshalOOneOfCatInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfCatInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			member.Cat = data
+			it = member
+		case "dog":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dog"))
+			var member OneOfPetInputDog
+			data, err := ec.unmarshalOString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			member.Dog = data
+			it = member
+		case "tags":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			var member OneOfPetInputTags
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			member.Tags = data
+			it = member
+		}
+	}
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNOneOfOwnerInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfOwnerInput(ctx context.Context, v any) (OneOfOwnerInput, error) {
+	res, err := ec.unmarshalInputOneOfOwnerInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNOneOfPetInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfPetInput(ctx context.Context, v any) (OneOfPetInput, error) {
+	res, err := ec.unmarshalInputOneOfPetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOOneOfCatInput2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfCatInput(ctx context.Context, v any) (*OneOfCatInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputOneOfCatInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOOneOfPetInput2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐOneOfPetInput(ctx context.Context, v any) (OneOfPetInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputOneOfPetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+// endregion ***************************** type.gotpl *****************************
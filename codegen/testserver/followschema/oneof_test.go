@@ -0,0 +1,94 @@
+package followschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func describeOneOfPet(pet OneOfPetInput) string {
+	switch pet := pet.(type) {
+	case OneOfPetInputCat:
+		return fmt.Sprintf("cat %s with %d lives", pet.Cat.Name, pet.Cat.Lives)
+	case OneOfPetInputDog:
+		return "dog " + pet.Dog
+	case OneOfPetInputTags:
+		return "tags " + strings.Join(pet.Tags, ",")
+	case nil:
+		return "no pet"
+	default:
+		return fmt.Sprintf("unexpected %T", pet)
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.OneOfPet = func(ctx context.Context, input OneOfPetInput) (string, error) {
+		return describeOneOfPet(input), nil
+	}
+	resolvers.QueryResolver.OneOfOwner = func(
+		ctx context.Context,
+		input OneOfOwnerInput,
+	) (string, error) {
+		return input.Name + " owns " + describeOneOfPet(input.Pet), nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("literal member", func(t *testing.T) {
+		var resp struct{ OneOfPet string }
+		c.MustPost(`{ oneOfPet(input: { cat: { name: "Tom", lives: 9 } }) }`, &resp)
+		require.Equal(t, "cat Tom with 9 lives", resp.OneOfPet)
+	})
+
+	t.Run("variable member", func(t *testing.T) {
+		var resp struct{ OneOfPet string }
+		c.MustPost(`query($pet: OneOfPetInput!) { oneOfPet(input: $pet) }`, &resp,
+			client.Var("pet", map[string]any{"tags": []string{"a", "b"}}))
+		require.Equal(t, "tags a,b", resp.OneOfPet)
+	})
+
+	t.Run("nested member", func(t *testing.T) {
+		var resp struct{ OneOfOwner string }
+		c.MustPost(`{ oneOfOwner(input: { name: "Ann", pet: { dog: "Rex" } }) }`, &resp)
+		require.Equal(t, "Ann owns dog Rex", resp.OneOfOwner)
+
+		c.MustPost(`{ oneOfOwner(input: { name: "Bob" }) }`, &resp)
+		require.Equal(t, "Bob owns no pet", resp.OneOfOwner)
+	})
+
+	t.Run("variables with more than one member are rejected", func(t *testing.T) {
+		resp, err := c.RawPost(`query($pet: OneOfPetInput!) { oneOfPet(input: $pet) }`,
+			client.Var("pet", map[string]any{"dog": "Rex", "tags": []string{"a"}}))
+		require.NoError(t, err)
+		require.Nil(t, resp.Data)
+		require.JSONEq(t, `[{
+			"message": "OneOf Input Object \"OneOfPetInput\" must specify exactly one key.",
+			"path": ["oneOfPet", "input"],
+			"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+		}]`, string(resp.Errors))
+	})
+
+	t.Run("variables with a null member are rejected", func(t *testing.T) {
+		resp, err := c.RawPost(
+			`query($pet: OneOfPetInput) { oneOfOwner(input: { name: "Ann", pet: $pet }) }`,
+			client.Var("pet", map[string]any{"dog": nil}),
+		)
+		require.NoError(t, err)
+		require.Nil(t, resp.Data)
+		require.JSONEq(t, `[{
+			"message": "Field \"OneOfPetInput.dog\" must be non-null.",
+			"path": ["oneOfOwner", "input", "pet"],
+			"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+		}]`, string(resp.Errors))
+	})
+}
@@ -58,9 +58,10 @@ func (ec *executionContext) field_Panics_fieldFuncMarshal_args(ctx context.Conte
 // region    **************************** field.gotpl *****************************
 
 func (ec *executionContext) _Panics_fieldScalarMarshal(ctx context.Context, field graphql.CollectedField, obj *Panics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Panics_fieldScalarMarshal(ctx, field)
@@ -74,6 +75,11 @@ func (ec *executionContext) _Panics_fieldScalarMarshal(ctx context.Context, fiel
 		func(ctx context.Context, selections ast.SelectionSet, v []MarshalPanic) graphql.Marshaler {
 			return ec.marshalNMarshalPanic2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanicᚄ(ctx, selections, v)
 		},
+		graphql.SliceItems[MarshalPanic],
+		func(ctx context.Context, selections ast.SelectionSet, v MarshalPanic) graphql.Marshaler {
+			return ec.marshalNMarshalPanic2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanic(ctx, selections, v)
+		},
+		true,
 		true,
 		true,
 	)
@@ -83,9 +89,10 @@ func (ec *executionContext) fieldContext_Panics_fieldScalarMarshal(_ context.Con
 }
 
 func (ec *executionContext) _Panics_fieldFuncMarshal(ctx context.Context, field graphql.CollectedField, obj *Panics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Panics_fieldFuncMarshal(ctx, field)
@@ -100,6 +107,11 @@ func (ec *executionContext) _Panics_fieldFuncMarshal(ctx context.Context, field
 		func(ctx context.Context, selections ast.SelectionSet, v []MarshalPanic) graphql.Marshaler {
 			return ec.marshalNMarshalPanic2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanicᚄ(ctx, selections, v)
 		},
+		graphql.SliceItems[MarshalPanic],
+		func(ctx context.Context, selections ast.SelectionSet, v MarshalPanic) graphql.Marshaler {
+			return ec.marshalNMarshalPanic2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanic(ctx, selections, v)
+		},
+		true,
 		true,
 		true,
 	)
@@ -208,7 +220,7 @@ func (ec *executionContext) _Panics(ctx context.Context, sel ast.SelectionSet, o
 					}
 				}()
 				res = ec._Panics_fieldScalarMarshal(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -246,7 +258,7 @@ func (ec *executionContext) _Panics(ctx context.Context, sel ast.SelectionSet, o
 					}
 				}()
 				res = ec._Panics_fieldFuncMarshal(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -284,7 +296,7 @@ func (ec *executionContext) _Panics(ctx context.Context, sel ast.SelectionSet, o
 					}
 				}()
 				res = ec._Panics_argUnmarshal(ctx, field, obj)
-				if res == graphql.Null {
+				if res == graphql.Null && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -340,7 +352,7 @@ func (ec *executionContext) _Panics(ctx context.Context, sel ast.SelectionSet, o
 func (ec *executionContext) unmarshalNMarshalPanic2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanic(ctx context.Context, v any) (MarshalPanic, error) {
 	var res MarshalPanic
 	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNMarshalPanic2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMarshalPanic(ctx context.Context, sel ast.SelectionSet, v MarshalPanic) graphql.Marshaler {
@@ -368,7 +380,7 @@ func (ec *executionContext) marshalNMarshalPanic2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 
 	for _, e := range ret {
-		if e == graphql.Null {
+		if e == graphql.Null && ec.PropagatesNulls() {
 			return graphql.Null
 		}
 	}
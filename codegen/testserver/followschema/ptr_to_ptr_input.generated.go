@@ -273,12 +273,12 @@ func (ec *executionContext) _PtrToPtrInner(ctx context.Context, sel ast.Selectio
 			out.Values[i] = graphql.MarshalString("PtrToPtrInner")
 		case "key":
 			out.Values[i] = ec._PtrToPtrInner_key(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "value":
 			out.Values[i] = ec._PtrToPtrInner_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -316,17 +316,17 @@ func (ec *executionContext) _PtrToPtrOuter(ctx context.Context, sel ast.Selectio
 			out.Values[i] = graphql.MarshalString("PtrToPtrOuter")
 		case "name":
 			out.Values[i] = ec._PtrToPtrOuter_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "inner":
 			out.Values[i] = ec._PtrToPtrOuter_inner(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "stupidInner":
 			out.Values[i] = ec._PtrToPtrOuter_stupidInner(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
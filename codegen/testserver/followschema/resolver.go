@@ -4,6 +4,7 @@ package followschema
 
 import (
 	"context"
+	"iter"
 
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/followschema/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/followschema/invalid-packagename"
@@ -17,6 +18,11 @@ func (r *backedByInterfaceResolver) ID(ctx context.Context, obj BackedByInterfac
 	panic("not implemented")
 }
 
+// Score is the resolver for the score field.
+func (r *cachedUserResolver) Score(ctx context.Context, obj *CachedUser) (int, error) {
+	panic("not implemented")
+}
+
 // OtherResolvedValue is the resolver for the otherResolvedValue field.
 func (r *deferModelResolver) OtherResolvedValue(ctx context.Context, obj *DeferModel) (string, error) {
 	panic("not implemented")
@@ -207,11 +213,26 @@ func (r *queryResolver) FieldWithDeprecatedArg(ctx context.Context, oldArg *int,
 	panic("not implemented")
 }
 
+// CachedRate is the resolver for the cachedRate field.
+func (r *queryResolver) CachedRate(ctx context.Context, currency string) (float64, error) {
+	panic("not implemented")
+}
+
+// CachedUsers is the resolver for the cachedUsers field.
+func (r *queryResolver) CachedUsers(ctx context.Context) ([]*CachedUser, error) {
+	panic("not implemented")
+}
+
 // Overlapping is the resolver for the overlapping field.
 func (r *queryResolver) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	panic("not implemented")
 }
 
+// ConstrainedOrder is the resolver for the constrainedOrder field.
+func (r *queryResolver) ConstrainedOrder(ctx context.Context, customer string, input ConstrainedOrderInput) (string, error) {
+	panic("not implemented")
+}
+
 // DefaultParameters is the resolver for the defaultParameters field.
 func (r *queryResolver) DefaultParameters(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error) {
 	panic("not implemented")
@@ -442,6 +463,16 @@ func (r *queryResolver) Invalid(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+// OneOfPet is the resolver for the oneOfPet field.
+func (r *queryResolver) OneOfPet(ctx context.Context, input OneOfPetInput) (string, error) {
+	panic("not implemented")
+}
+
+// OneOfOwner is the resolver for the oneOfOwner field.
+func (r *queryResolver) OneOfOwner(ctx context.Context, input OneOfOwnerInput) (string, error) {
+	panic("not implemented")
+}
+
 // Panics is the resolver for the panics field.
 func (r *queryResolver) Panics(ctx context.Context) (*Panics, error) {
 	panic("not implemented")
@@ -487,6 +518,16 @@ func (r *queryResolver) DefaultScalar(ctx context.Context, arg string) (string,
 	panic("not implemented")
 }
 
+// SemanticNonNull is the resolver for the semanticNonNull field.
+func (r *queryResolver) SemanticNonNull(ctx context.Context) (*SemanticNonNull, error) {
+	panic("not implemented")
+}
+
+// SemanticNonNullName is the resolver for the semanticNonNullName field.
+func (r *queryResolver) SemanticNonNullName(ctx context.Context) (string, error) {
+	panic("not implemented")
+}
+
 // SkipInclude is the resolver for the skipInclude field.
 func (r *queryResolver) SkipInclude(ctx context.Context) (*SkipIncludeTestType, error) {
 	panic("not implemented")
@@ -502,6 +543,21 @@ func (r *queryResolver) ScalarSlice(ctx context.Context) ([]byte, error) {
 	panic("not implemented")
 }
 
+// StreamSlice is the resolver for the streamSlice field.
+func (r *queryResolver) StreamSlice(ctx context.Context) ([]*StreamItem, error) {
+	panic("not implemented")
+}
+
+// StreamSeq is the resolver for the streamSeq field.
+func (r *queryResolver) StreamSeq(ctx context.Context) (iter.Seq[*StreamItem], error) {
+	panic("not implemented")
+}
+
+// StreamChan is the resolver for the streamChan field.
+func (r *queryResolver) StreamChan(ctx context.Context) (<-chan *string, error) {
+	panic("not implemented")
+}
+
 // Fallback is the resolver for the fallback field.
 func (r *queryResolver) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	panic("not implemented")
@@ -552,6 +608,11 @@ func (r *queryResolver) WrappedSlice(ctx context.Context) (WrappedSlice, error)
 	panic("not implemented")
 }
 
+// Name is the resolver for the name field.
+func (r *streamItemResolver) Name(ctx context.Context, obj *StreamItem) (string, error) {
+	panic("not implemented")
+}
+
 // Updated is the resolver for the updated field.
 func (r *subscriptionResolver) Updated(ctx context.Context) (<-chan string, error) {
 	panic("not implemented")
@@ -617,6 +678,9 @@ func (r *Resolver) BackedByInterface() BackedByInterfaceResolver {
 	return &backedByInterfaceResolver{r}
 }
 
+// CachedUser returns CachedUserResolver implementation.
+func (r *Resolver) CachedUser() CachedUserResolver { return &cachedUserResolver{r} }
+
 // DeferModel returns DeferModelResolver implementation.
 func (r *Resolver) DeferModel() DeferModelResolver { return &deferModelResolver{r} }
 
@@ -652,6 +716,9 @@ func (r *Resolver) PrimitiveString() PrimitiveStringResolver { return &primitive
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// StreamItem returns StreamItemResolver implementation.
+func (r *Resolver) StreamItem() StreamItemResolver { return &streamItemResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -666,6 +733,7 @@ func (r *Resolver) WrappedSlice() WrappedSliceResolver { return &wrappedSliceRes
 
 type (
 	backedByInterfaceResolver struct{ *Resolver }
+	cachedUserResolver        struct{ *Resolver }
 	deferModelResolver        struct{ *Resolver }
 	errorsResolver            struct{ *Resolver }
 	forcedResolverResolver    struct{ *Resolver }
@@ -677,6 +745,7 @@ type (
 	primitiveResolver         struct{ *Resolver }
 	primitiveStringResolver   struct{ *Resolver }
 	queryResolver             struct{ *Resolver }
+	streamItemResolver        struct{ *Resolver }
 	subscriptionResolver      struct{ *Resolver }
 	userResolver              struct{ *Resolver }
 	wrappedMapResolver        struct{ *Resolver }
@@ -23,6 +23,7 @@ type Config = graphql.Config[ResolverRoot, DirectiveRoot, ComplexityRoot]
 
 type ResolverRoot interface {
 	BackedByInterface() BackedByInterfaceResolver
+	CachedUser() CachedUserResolver
 	DeferModel() DeferModelResolver
 	Errors() ErrorsResolver
 	ForcedResolver() ForcedResolverResolver
@@ -34,6 +35,7 @@ type ResolverRoot interface {
 	Primitive() PrimitiveResolver
 	PrimitiveString() PrimitiveStringResolver
 	Query() QueryResolver
+	StreamItem() StreamItemResolver
 	Subscription() SubscriptionResolver
 	User() UserResolver
 	WrappedMap() WrappedMapResolver
@@ -96,6 +98,11 @@ type ComplexityRoot struct {
 		ThisShouldBindWithError func(childComplexity int) int
 	}
 
+	CachedUser struct {
+		ID    func(childComplexity int) int
+		Score func(childComplexity int) int
+	}
+
 	Cat struct {
 		CatBreed func(childComplexity int) int
 		Size     func(childComplexity int) int
@@ -319,7 +326,10 @@ type ComplexityRoot struct {
 	Query struct {
 		Animal                           func(childComplexity int) int
 		Autobind                         func(childComplexity int) int
+		CachedRate                       func(childComplexity int, currency string) int
+		CachedUsers                      func(childComplexity int) int
 		Collision                        func(childComplexity int) int
+		ConstrainedOrder                 func(childComplexity int, customer string, input ConstrainedOrderInput) int
 		DefaultParameters                func(childComplexity int, falsyBoolean *bool, truthyBoolean *bool) int
 		DefaultScalar                    func(childComplexity int, arg string) int
 		DeferMultiple                    func(childComplexity int) int
@@ -371,6 +381,8 @@ type ComplexityRoot struct {
 		Node                             func(childComplexity int) int
 		NotAnInterface                   func(childComplexity int) int
 		NullableArg                      func(childComplexity int, arg *int) int
+		OneOfOwner                       func(childComplexity int, input OneOfOwnerInput) int
+		OneOfPet                         func(childComplexity int, input OneOfPetInput) int
 		OptionalUnion                    func(childComplexity int) int
 		Overlapping                      func(childComplexity int) int
 		Panics                           func(childComplexity int) int
@@ -386,10 +398,15 @@ type ComplexityRoot struct {
 		SearchRequired                   func(childComplexity int, name string, age int) int
 		SearchWithDefaults               func(childComplexity int, query *string, limit *int, includeArchived *bool) int
 		SearchWithDirectives             func(childComplexity int, oldField *string, newField *string) int
+		SemanticNonNull                  func(childComplexity int) int
+		SemanticNonNullName              func(childComplexity int) int
 		ShapeUnion                       func(childComplexity int) int
 		Shapes                           func(childComplexity int) int
 		SkipInclude                      func(childComplexity int) int
 		Slices                           func(childComplexity int) int
+		StreamChan                       func(childComplexity int) int
+		StreamSeq                        func(childComplexity int) int
+		StreamSlice                      func(childComplexity int) int
 		StringFromContextFunction        func(childComplexity int) int
 		StringFromContextInterface       func(childComplexity int) int
 		User                             func(childComplexity int, id int) int
@@ -411,6 +428,13 @@ type ComplexityRoot struct {
 		Width       func(childComplexity int) int
 	}
 
+	SemanticNonNull struct {
+		Friend  func(childComplexity int) int
+		Friends func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Tags    func(childComplexity int) int
+	}
+
 	Size struct {
 		Height func(childComplexity int) int
 		Weight func(childComplexity int) int
@@ -428,6 +452,11 @@ type ComplexityRoot struct {
 		Test4 func(childComplexity int) int
 	}
 
+	StreamItem struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
 	Subscription struct {
 		DirectiveArg           func(childComplexity int, arg string) int
 		DirectiveDouble        func(childComplexity int) int
@@ -496,6 +525,10 @@ type ComplexityRoot struct {
 	}
 }
 
+var (
+	builtInDirectiveCached = graphql.CachedDirective
+)
+
 type executableSchema graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot]
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -588,6 +621,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.BackedByInterface.ThisShouldBindWithError(childComplexity), true
 
+	case "CachedUser.id":
+		if e.ComplexityRoot.CachedUser.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CachedUser.ID(childComplexity), true
+	case "CachedUser.score":
+		if e.ComplexityRoot.CachedUser.Score == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CachedUser.Score(childComplexity), true
+
 	case "Cat.catBreed":
 		if e.ComplexityRoot.Cat.CatBreed == nil {
 			break
@@ -1234,12 +1280,40 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.Autobind(childComplexity), true
+	case "Query.cachedRate":
+		if e.ComplexityRoot.Query.CachedRate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_cachedRate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.CachedRate(childComplexity, args["currency"].(string)), true
+	case "Query.cachedUsers":
+		if e.ComplexityRoot.Query.CachedUsers == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.CachedUsers(childComplexity), true
 	case "Query.collision":
 		if e.ComplexityRoot.Query.Collision == nil {
 			break
 		}
 
 		return e.ComplexityRoot.Query.Collision(childComplexity), true
+	case "Query.constrainedOrder":
+		if e.ComplexityRoot.Query.ConstrainedOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Query_constrainedOrder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.ConstrainedOrder(childComplexity, args["customer"].(string), args["input"].(ConstrainedOrderInput)), true
 	case "Query.defaultParameters":
 		if e.ComplexityRoot.Query.DefaultParameters == nil {
 			break
@@ -1672,6 +1746,28 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.NullableArg(childComplexity, args["arg"].(*int)), true
+	case "Query.oneOfOwner":
+		if e.ComplexityRoot.Query.OneOfOwner == nil {
+			break
+		}
+
+		args, err := ec.field_Query_oneOfOwner_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.OneOfOwner(childComplexity, args["input"].(OneOfOwnerInput)), true
+	case "Query.oneOfPet":
+		if e.ComplexityRoot.Query.OneOfPet == nil {
+			break
+		}
+
+		args, err := ec.field_Query_oneOfPet_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.OneOfPet(childComplexity, args["input"].(OneOfPetInput)), true
 	case "Query.optionalUnion":
 		if e.ComplexityRoot.Query.OptionalUnion == nil {
 			break
@@ -1797,6 +1893,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.SearchWithDirectives(childComplexity, args["oldField"].(*string), args["newField"].(*string)), true
+	case "Query.semanticNonNull":
+		if e.ComplexityRoot.Query.SemanticNonNull == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.SemanticNonNull(childComplexity), true
+	case "Query.semanticNonNullName":
+		if e.ComplexityRoot.Query.SemanticNonNullName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.SemanticNonNullName(childComplexity), true
 	case "Query.shapeUnion":
 		if e.ComplexityRoot.Query.ShapeUnion == nil {
 			break
@@ -1821,6 +1929,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.Slices(childComplexity), true
+	case "Query.streamChan":
+		if e.ComplexityRoot.Query.StreamChan == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.StreamChan(childComplexity), true
+	case "Query.streamSeq":
+		if e.ComplexityRoot.Query.StreamSeq == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.StreamSeq(childComplexity), true
+	case "Query.streamSlice":
+		if e.ComplexityRoot.Query.StreamSlice == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.StreamSlice(childComplexity), true
 	case "Query.stringFromContextFunction":
 		if e.ComplexityRoot.Query.StringFromContextFunction == nil {
 			break
@@ -1924,6 +2050,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Rectangle.Width(childComplexity), true
 
+	case "SemanticNonNull.friend":
+		if e.ComplexityRoot.SemanticNonNull.Friend == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SemanticNonNull.Friend(childComplexity), true
+	case "SemanticNonNull.friends":
+		if e.ComplexityRoot.SemanticNonNull.Friends == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SemanticNonNull.Friends(childComplexity), true
+	case "SemanticNonNull.name":
+		if e.ComplexityRoot.SemanticNonNull.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SemanticNonNull.Name(childComplexity), true
+	case "SemanticNonNull.tags":
+		if e.ComplexityRoot.SemanticNonNull.Tags == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SemanticNonNull.Tags(childComplexity), true
+
 	case "Size.height":
 		if e.ComplexityRoot.Size.Height == nil {
 			break
@@ -1975,6 +2126,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Slices.Test4(childComplexity), true
 
+	case "StreamItem.id":
+		if e.ComplexityRoot.StreamItem.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StreamItem.ID(childComplexity), true
+	case "StreamItem.name":
+		if e.ComplexityRoot.StreamItem.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.StreamItem.Name(childComplexity), true
+
 	case "Subscription.directiveArg":
 		if e.ComplexityRoot.Subscription.DirectiveArg == nil {
 			break
@@ -2205,6 +2369,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputChanges,
+		ec.unmarshalInputConstrainedItemInput,
+		ec.unmarshalInputConstrainedOrderInput,
 		ec.unmarshalInputDefaultInput,
 		ec.unmarshalInputDirectiveInput,
 		ec.unmarshalInputFieldsOrderInput,
@@ -2221,6 +2387,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputNestedInput,
 		ec.unmarshalInputNestedMapInput,
 		ec.unmarshalInputOmittableInput,
+		ec.unmarshalInputOneOfCatInput,
+		ec.unmarshalInputOneOfOwnerInput,
+		ec.unmarshalInputOneOfPetInput,
 		ec.unmarshalInputOuterInput,
 		ec.unmarshalInputOuterWrapperInput,
 		ec.unmarshalInputRecursiveInputSlice,
@@ -2247,16 +2416,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 					return ec._Query(ctx, opCtx.Operation.SelectionSet), nil
 				})
 			} else {
-				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
-					result := <-ec.DeferredResults
-					atomic.AddInt32(&ec.PendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
+				return ec.NextDeferredResponse()
 			}
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
@@ -2330,7 +2490,9 @@ func newExecutionContext(
 }
 
 var sources = []*ast.Source{
-	{Name: "inline_arguments_transformed_schema.graphql", Input: `directive @custom on ARGUMENT_DEFINITION
+	{Name: "inline_arguments_transformed_schema.graphql", Input: `directive @cached(ttl: Int!, key: String) on FIELD_DEFINITION
+directive @constraint(minLength: Int, maxLength: Int, pattern: String, min: Float, max: Float, format: String, minItems: Int, maxItems: Int) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+directive @custom on ARGUMENT_DEFINITION
 directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
 directive @directive1 on FIELD_DEFINITION
 directive @directive2 on FIELD_DEFINITION
@@ -2351,6 +2513,8 @@ directive @order2(location: String!) on OBJECT
 directive @populate(value: String!) on ARGUMENT_DEFINITION
 directive @queryOnly(reason: String!) on QUERY
 directive @range(min: Int = 0, max: Int) on ARGUMENT_DEFINITION
+directive @semanticNonNull(levels: [Int] = [0]) on FIELD_DEFINITION
+directive @stream(initialCount: Int = 0, label: String, if: Boolean = true) on FIELD
 directive @subscriptionOnly(reason: String!) on SUBSCRIPTION
 directive @toNull on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 directive @unimplemented on FIELD_DEFINITION
@@ -2384,6 +2548,10 @@ type BackedByInterface {
 	thisShouldBindWithError: String!
 }
 scalar Bytes
+type CachedUser {
+	id: ID!
+	score: Int! @cached(ttl: 60, key: "id") @goField(forceResolver: true)
+}
 type Cat implements Animal {
 	species: String!
 	size: Size!
@@ -2413,6 +2581,17 @@ type ConcreteNodeInterface implements Node {
 	id: ID!
 	child: Node!
 }
+input ConstrainedItemInput {
+	name: String! @constraint(minLength: 1, pattern: "^[A-Za-z ]+$")
+	quantity: Int! @constraint(min: 1, max: 100)
+}
+input ConstrainedOrderInput {
+	email: String! @constraint(format: "email")
+	website: String @constraint(format: "uri")
+	reference: ID @constraint(format: "uuid")
+	items: [ConstrainedItemInput!]! @constraint(minItems: 1, maxItems: 3)
+	tags: [String!] @constraint(pattern: "^[a-z]+$", maxItems: 2)
+}
 union Content_Child = Content_User | Content_Post
 type Content_Post {
 	foo: String
@@ -2626,6 +2805,19 @@ input OmittableInput {
 	scalar: ThirdParty @goField(omittable: true)
 	object: OuterInput @goField(omittable: true)
 }
+input OneOfCatInput {
+	name: String!
+	lives: Int!
+}
+input OneOfOwnerInput {
+	name: String!
+	pet: OneOfPetInput
+}
+input OneOfPetInput @oneOf {
+	cat: OneOfCatInput
+	dog: String
+	tags: [String!]
+}
 input OuterInput {
 	inner: InnerInput!
 }
@@ -2693,7 +2885,10 @@ type Query {
 	autobind: Autobind
 	deprecatedField: String! @deprecated(reason: "test deprecated directive")
 	fieldWithDeprecatedArg(oldArg: Int @deprecated(reason: "old arg"), newArg: Int): String
+	cachedRate(currency: String!): Float! @cached(ttl: 60)
+	cachedUsers: [CachedUser!]!
 	overlapping: OverlappingFields
+	constrainedOrder(customer: String! @constraint(minLength: 2, maxLength: 20), input: ConstrainedOrderInput!): String!
 	defaultParameters(falsyBoolean: Boolean = false, truthyBoolean: Boolean = true): DefaultParametersMirror!
 	deferSingle: DeferModel
 	deferMultiple: [DeferModel!]
@@ -2740,6 +2935,8 @@ type Query {
 	errors: Errors
 	valid: String!
 	invalid: String!
+	oneOfPet(input: OneOfPetInput!): String!
+	oneOfOwner(input: OneOfOwnerInput!): String!
 	panics: Panics
 	primitiveObject: [Primitive!]!
 	primitiveStringObject: [PrimitiveString!]!
@@ -2749,9 +2946,14 @@ type Query {
 	stringFromContextInterface: StringFromContextInterface!
 	stringFromContextFunction: StringFromContextFunction!
 	defaultScalar(arg: DefaultScalarImplementation! = "default"): DefaultScalarImplementation!
+	semanticNonNull: SemanticNonNull!
+	semanticNonNullName: String @semanticNonNull
 	skipInclude: SkipIncludeTestType
 	slices: Slices
 	scalarSlice: Bytes!
+	streamSlice: [StreamItem!]!
+	streamSeq: [StreamItem!]!
+	streamChan: [String]
 	fallback(arg: FallbackToStringEncoding!): FallbackToStringEncoding!
 	optionalUnion: TestUnion
 	vOkCaseValue: VOkCaseValue
@@ -2786,6 +2988,12 @@ input SearchWithDefaults @goModel(model: "map[string]interface{}") {
 	limit: Int = 20
 	includeArchived: Boolean = false
 }
+type SemanticNonNull {
+	name: String @semanticNonNull
+	friend: SemanticNonNull @semanticNonNull
+	tags: [String] @semanticNonNull(levels: [0,1])
+	friends: [SemanticNonNull] @semanticNonNull(levels: [1])
+}
 interface Shape {
 	area: Float
 	coordinates: Coordinates
@@ -2812,6 +3020,10 @@ enum Status {
 	OK
 	ERROR
 }
+type StreamItem {
+	id: ID!
+	name: String! @goField(forceResolver: true)
+}
 scalar StringFromContextFunction
 scalar StringFromContextInterface
 type Subscription {
@@ -2918,6 +3130,21 @@ type asdfIt {
 type iIt {
 	id: ID!
 }
+`, BuiltIn: true},
+	{Name: "gqlgen/introspection.graphql", Input: `"The behavior of the executor when a field raises an error."
+enum __ErrorBehavior {
+  "The failing field is null, even when it is non-null."
+  NULL
+  "The null of a failing non-null field propagates to its closest nullable parent."
+  PROPAGATE
+  "The operation stops at the first error and its data is null."
+  HALT
+}
+
+extend type __Schema {
+  "The error behavior of requests that do not select one with onError."
+  defaultErrorBehavior: __ErrorBehavior!
+}
 `, BuiltIn: true},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -2954,6 +3181,16 @@ func (ec *executionContext) childFields_BackedByInterface(ctx context.Context, f
 	return nil, fmt.Errorf("no field named %q was found under type BackedByInterface", field.Name)
 }
 
+func (ec *executionContext) childFields_CachedUser(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_CachedUser_id(ctx, field)
+	case "score":
+		return ec.fieldContext_CachedUser_score(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CachedUser", field.Name)
+}
+
 func (ec *executionContext) childFields_CheckIssue896(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -3284,6 +3521,20 @@ func (ec *executionContext) childFields_PtrToSliceContainer(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type PtrToSliceContainer", field.Name)
 }
 
+func (ec *executionContext) childFields_SemanticNonNull(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext_SemanticNonNull_name(ctx, field)
+	case "friend":
+		return ec.fieldContext_SemanticNonNull_friend(ctx, field)
+	case "tags":
+		return ec.fieldContext_SemanticNonNull_tags(ctx, field)
+	case "friends":
+		return ec.fieldContext_SemanticNonNull_friends(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SemanticNonNull", field.Name)
+}
+
 func (ec *executionContext) childFields_Size(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "height":
@@ -3318,6 +3569,16 @@ func (ec *executionContext) childFields_Slices(ctx context.Context, field graphq
 	return nil, fmt.Errorf("no field named %q was found under type Slices", field.Name)
 }
 
+func (ec *executionContext) childFields_StreamItem(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_StreamItem_id(ctx, field)
+	case "name":
+		return ec.fieldContext_StreamItem_name(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type StreamItem", field.Name)
+}
+
 func (ec *executionContext) childFields_User(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -3478,6 +3739,8 @@ func (ec *executionContext) childFields___Schema(ctx context.Context, field grap
 		return ec.fieldContext___Schema_subscriptionType(ctx, field)
 	case "directives":
 		return ec.fieldContext___Schema_directives(ctx, field)
+	case "defaultErrorBehavior":
+		return ec.fieldContext___Schema_defaultErrorBehavior(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 }
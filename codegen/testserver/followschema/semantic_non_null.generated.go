@@ -0,0 +1,281 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _SemanticNonNull_name(ctx context.Context, field graphql.CollectedField, obj *SemanticNonNull) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SemanticNonNull_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			return ec._fieldMiddleware(ctx, obj, next)
+		},
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalOString2string(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SemanticNonNull_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:                "SemanticNonNull",
+		Field:                 field,
+		IsMethod:              false,
+		IsResolver:            false,
+		SemanticNonNullLevels: []int{0},
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemanticNonNull_friend(ctx context.Context, field graphql.CollectedField, obj *SemanticNonNull) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SemanticNonNull_friend(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Friend, nil
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			return ec._fieldMiddleware(ctx, obj, next)
+		},
+		func(ctx context.Context, selections ast.SelectionSet, v *SemanticNonNull) graphql.Marshaler {
+			return ec.marshalOSemanticNonNull2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SemanticNonNull_friend(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:                "SemanticNonNull",
+		Field:                 field,
+		IsMethod:              false,
+		IsResolver:            false,
+		SemanticNonNullLevels: []int{0},
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SemanticNonNull(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemanticNonNull_tags(ctx context.Context, field graphql.CollectedField, obj *SemanticNonNull) (ret graphql.Marshaler) {
+	return graphql.ResolveFieldList(
+		ctx,
+		ec.OperationContext,
+		ec,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SemanticNonNull_tags(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Tags, nil
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			return ec._fieldMiddleware(ctx, obj, next)
+		},
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalOString2ᚕstring(ctx, selections, v)
+		},
+		graphql.SliceItems[string],
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalOString2string(ctx, selections, v)
+		},
+		true,
+		false,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SemanticNonNull_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:                "SemanticNonNull",
+		Field:                 field,
+		IsMethod:              false,
+		IsResolver:            false,
+		SemanticNonNullLevels: []int{0, 1},
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemanticNonNull_friends(ctx context.Context, field graphql.CollectedField, obj *SemanticNonNull) (ret graphql.Marshaler) {
+	return graphql.ResolveFieldList(
+		ctx,
+		ec.OperationContext,
+		ec,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SemanticNonNull_friends(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Friends, nil
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			return ec._fieldMiddleware(ctx, obj, next)
+		},
+		func(ctx context.Context, selections ast.SelectionSet, v []*SemanticNonNull) graphql.Marshaler {
+			return ec.marshalOSemanticNonNull2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx, selections, v)
+		},
+		graphql.SliceItems[*SemanticNonNull],
+		func(ctx context.Context, selections ast.SelectionSet, v *SemanticNonNull) graphql.Marshaler {
+			return ec.marshalOSemanticNonNull2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx, selections, v)
+		},
+		true,
+		false,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SemanticNonNull_friends(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:                "SemanticNonNull",
+		Field:                 field,
+		IsMethod:              false,
+		IsResolver:            false,
+		SemanticNonNullLevels: []int{1},
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SemanticNonNull(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var semanticNonNullImplementors = []string{"SemanticNonNull"}
+
+func (ec *executionContext) _SemanticNonNull(ctx context.Context, sel ast.SelectionSet, obj *SemanticNonNull) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, semanticNonNullImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SemanticNonNull")
+		case "name":
+			out.Values[i] = ec._SemanticNonNull_name(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
+				out.Invalids++
+			}
+		case "friend":
+			out.Values[i] = ec._SemanticNonNull_friend(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
+				out.Invalids++
+			}
+		case "tags":
+			out.Values[i] = ec._SemanticNonNull_tags(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
+				out.Invalids++
+			}
+		case "friends":
+			out.Values[i] = ec._SemanticNonNull_friends(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNSemanticNonNull2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx context.Context, sel ast.SelectionSet, v SemanticNonNull) graphql.Marshaler {
+	return ec._SemanticNonNull(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSemanticNonNull2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx context.Context, sel ast.SelectionSet, v *SemanticNonNull) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SemanticNonNull(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOSemanticNonNull2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx context.Context, sel ast.SelectionSet, v []*SemanticNonNull) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalOSemanticNonNull2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx, sel, v[i])
+	})
+
+	return ret
+}
+
+func (ec *executionContext) marshalOSemanticNonNull2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐSemanticNonNull(ctx context.Context, sel ast.SelectionSet, v *SemanticNonNull) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SemanticNonNull(ctx, sel, v)
+}
+
+// endregion ***************************** type.gotpl *****************************
@@ -99,12 +99,12 @@ func (ec *executionContext) _SkipIncludeTestType(ctx context.Context, sel ast.Se
 			out.Values[i] = graphql.MarshalString("SkipIncludeTestType")
 		case "a":
 			out.Values[i] = ec._SkipIncludeTestType_a(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "b":
 			out.Values[i] = ec._SkipIncludeTestType_b(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
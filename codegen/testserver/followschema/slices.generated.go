@@ -24,9 +24,10 @@ import (
 // region    **************************** field.gotpl *****************************
 
 func (ec *executionContext) _Slices_test1(ctx context.Context, field graphql.CollectedField, obj *Slices) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Slices_test1(ctx, field)
@@ -40,8 +41,13 @@ func (ec *executionContext) _Slices_test1(ctx context.Context, field graphql.Col
 		func(ctx context.Context, selections ast.SelectionSet, v []*string) graphql.Marshaler {
 			return ec.marshalOString2ᚕᚖstring(ctx, selections, v)
 		},
+		graphql.SliceItems[*string],
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
 		true,
 		false,
+		false,
 	)
 }
 func (ec *executionContext) fieldContext_Slices_test1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -49,9 +55,10 @@ func (ec *executionContext) fieldContext_Slices_test1(_ context.Context, field g
 }
 
 func (ec *executionContext) _Slices_test2(ctx context.Context, field graphql.CollectedField, obj *Slices) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Slices_test2(ctx, field)
@@ -65,8 +72,13 @@ func (ec *executionContext) _Slices_test2(ctx context.Context, field graphql.Col
 		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
 			return ec.marshalOString2ᚕstringᚄ(ctx, selections, v)
 		},
+		graphql.SliceItems[string],
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
 		true,
 		false,
+		true,
 	)
 }
 func (ec *executionContext) fieldContext_Slices_test2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -74,9 +86,10 @@ func (ec *executionContext) fieldContext_Slices_test2(_ context.Context, field g
 }
 
 func (ec *executionContext) _Slices_test3(ctx context.Context, field graphql.CollectedField, obj *Slices) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Slices_test3(ctx, field)
@@ -90,8 +103,13 @@ func (ec *executionContext) _Slices_test3(ctx context.Context, field graphql.Col
 		func(ctx context.Context, selections ast.SelectionSet, v []*string) graphql.Marshaler {
 			return ec.marshalNString2ᚕᚖstring(ctx, selections, v)
 		},
+		graphql.SliceItems[*string],
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
 		true,
 		true,
+		false,
 	)
 }
 func (ec *executionContext) fieldContext_Slices_test3(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -99,9 +117,10 @@ func (ec *executionContext) fieldContext_Slices_test3(_ context.Context, field g
 }
 
 func (ec *executionContext) _Slices_test4(ctx context.Context, field graphql.CollectedField, obj *Slices) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+	return graphql.ResolveFieldList(
 		ctx,
 		ec.OperationContext,
+		ec,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return ec.fieldContext_Slices_test4(ctx, field)
@@ -115,6 +134,11 @@ func (ec *executionContext) _Slices_test4(ctx context.Context, field graphql.Col
 		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
 			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
 		},
+		graphql.SliceItems[string],
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
 		true,
 		true,
 	)
@@ -149,22 +173,22 @@ func (ec *executionContext) _Slices(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = graphql.MarshalString("Slices")
 		case "test1":
 			out.Values[i] = ec._Slices_test1(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "test2":
 			out.Values[i] = ec._Slices_test2(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			if out.Values[i] == graphql.RequiredNull && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "test3":
 			out.Values[i] = ec._Slices_test3(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		case "test4":
 			out.Values[i] = ec._Slices_test4(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -194,7 +218,7 @@ func (ec *executionContext) _Slices(ctx context.Context, sel ast.SelectionSet, o
 
 func (ec *executionContext) unmarshalNBytes2ᚕbyte(ctx context.Context, v any) ([]byte, error) {
 	res, err := UnmarshalBytes(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return res, graphql.CoercionErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNBytes2ᚕbyte(ctx context.Context, sel ast.SelectionSet, v []byte) graphql.Marshaler {
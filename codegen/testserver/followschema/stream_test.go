@@ -0,0 +1,258 @@
+package followschema
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"testing"
+	"testing/synctest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	resolvers := &Stub{}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.Websocket{})
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.MultipartMixed{})
+	srv.AddTransport(transport.POST{})
+
+	c := client.New(srv)
+
+	items := []*StreamItem{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	resolvers.QueryResolver.StreamSlice = func(ctx context.Context) ([]*StreamItem, error) {
+		return items, nil
+	}
+	resolvers.StreamItemResolver.Name = func(ctx context.Context, obj *StreamItem) (string, error) {
+		return "item " + obj.ID, nil
+	}
+	resolvers.QueryResolver.StreamSeq = func(ctx context.Context) (iter.Seq[*StreamItem], error) {
+		return func(yield func(*StreamItem) bool) {
+			for _, item := range items {
+				if !yield(item) {
+					return
+				}
+			}
+		}, nil
+	}
+	resolvers.QueryResolver.StreamChan = func(ctx context.Context) (<-chan *string, error) {
+		a, b := "a", "b"
+		ch := make(chan *string, 3)
+		ch <- &a
+		ch <- nil
+		ch <- &b
+		close(ch)
+		return ch, nil
+	}
+
+	type (
+		streamItem struct {
+			ID   string
+			Name string
+		}
+
+		response struct {
+			Data       map[string]any  `json:"data"`
+			Label      string          `json:"label"`
+			Path       []any           `json:"path"`
+			HasNext    bool            `json:"hasNext"`
+			Errors     json.RawMessage `json:"errors"`
+			Extensions map[string]any  `json:"extensions"`
+		}
+
+		streamedItems struct {
+			Data       any             `json:"data"`
+			Items      []any           `json:"items"`
+			Label      string          `json:"label"`
+			Path       []any           `json:"path"`
+			HasNext    bool            `json:"hasNext"`
+			Errors     json.RawMessage `json:"errors"`
+			Extensions map[string]any  `json:"extensions"`
+		}
+
+		incrementalResponse struct {
+			Incremental []streamedItems `json:"incremental"`
+			HasNext     bool            `json:"hasNext"`
+		}
+	)
+
+	t.Run("without @stream", func(t *testing.T) {
+		var resp struct {
+			StreamSlice []streamItem
+			StreamSeq   []streamItem
+			StreamChan  []*string
+		}
+		c.MustPost(`{ streamSlice { id name } streamSeq { id } streamChan }`, &resp)
+
+		require.Equal(t, []streamItem{
+			{ID: "1", Name: "item 1"},
+			{ID: "2", Name: "item 2"},
+			{ID: "3", Name: "item 3"},
+		}, resp.StreamSlice)
+		require.Equal(t, []streamItem{{ID: "1"}, {ID: "2"}, {ID: "3"}}, resp.StreamSeq)
+		require.Len(t, resp.StreamChan, 3)
+		require.Nil(t, resp.StreamChan[1])
+	})
+
+	t.Run("when if arg is false", func(t *testing.T) {
+		var resp struct {
+			StreamSlice []streamItem
+		}
+		c.MustPost(`{ streamSlice @stream(if: false) { id } }`, &resp)
+
+		require.Equal(t, []streamItem{{ID: "1"}, {ID: "2"}, {ID: "3"}}, resp.StreamSlice)
+	})
+
+	t.Run("with a negative initialCount", func(t *testing.T) {
+		var resp struct {
+			StreamSlice []streamItem
+		}
+		err := c.Post(`{ streamSlice @stream(initialCount: -1) { id } }`, &resp)
+
+		require.EqualError(
+			t,
+			err,
+			`[{"message":"initialCount of @stream must not be negative","path":["streamSlice"]}]`,
+		)
+	})
+
+	t.Run("over SSE", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			read := c.SSE(
+				context.Background(),
+				`{ streamSlice @stream(initialCount: 1, label: "slice") { id name } }`,
+			)
+
+			var initial response
+			require.NoError(t, read.Next(&initial))
+			assert.Equal(t, response{
+				Data: map[string]any{
+					"streamSlice": []any{map[string]any{"id": "1", "name": "item 1"}},
+				},
+				HasNext: true,
+			}, initial)
+
+			var streamed []streamedItems
+			for {
+				var resp incrementalResponse
+				require.NoError(t, read.Next(&resp))
+				streamed = append(streamed, resp.Incremental...)
+				if !resp.HasNext {
+					break
+				}
+			}
+			require.NoError(t, read.Close())
+
+			require.Equal(t, []streamedItems{
+				{
+					Items:   []any{map[string]any{"id": "2", "name": "item 2"}},
+					Label:   "slice",
+					Path:    []any{"streamSlice", float64(1)},
+					HasNext: true,
+				},
+				{
+					Items:   []any{map[string]any{"id": "3", "name": "item 3"}},
+					Label:   "slice",
+					Path:    []any{"streamSlice", float64(2)},
+					HasNext: true,
+				},
+			}, streamed)
+		})
+	})
+
+	t.Run("over multipart HTTP", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			read := c.IncrementalHTTP(context.Background(), `{ streamChan @stream }`)
+
+			var initial response
+			synctest.Wait()
+			require.NoError(t, read.Next(&initial))
+			assert.Equal(t, map[string]any{"streamChan": []any{}}, initial.Data)
+			assert.True(t, initial.HasNext)
+
+			var streamed []any
+			for {
+				var resp incrementalResponse
+				synctest.Wait()
+				require.NoError(t, read.Next(&resp))
+				for _, inc := range resp.Incremental {
+					streamed = append(streamed, inc.Items...)
+				}
+				if !resp.HasNext {
+					break
+				}
+			}
+			require.NoError(t, read.Close())
+
+			require.Equal(t, []any{"a", nil, "b"}, streamed)
+		})
+	})
+
+	t.Run("from an iter.Seq", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			read := c.SSE(context.Background(), `{ streamSeq @stream(initialCount: 1) { id } }`)
+
+			var initial response
+			require.NoError(t, read.Next(&initial))
+			assert.Equal(t, map[string]any{
+				"streamSeq": []any{map[string]any{"id": "1"}},
+			}, initial.Data)
+
+			var ids []any
+			for {
+				var resp incrementalResponse
+				require.NoError(t, read.Next(&resp))
+				for _, inc := range resp.Incremental {
+					for _, item := range inc.Items {
+						ids = append(ids, item.(map[string]any)["id"])
+					}
+				}
+				if !resp.HasNext {
+					break
+				}
+			}
+			require.NoError(t, read.Close())
+
+			require.Equal(t, []any{"2", "3"}, ids)
+		})
+	})
+
+	t.Run("over websocket", func(t *testing.T) {
+		sock := c.Websocket(`{ streamSlice @stream(initialCount: 2) { id } }`)
+		defer func() { _ = sock.Close() }()
+
+		var initial response
+		require.NoError(t, sock.NextResponse(&initial))
+		assert.Equal(t, map[string]any{
+			"streamSlice": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+		}, initial.Data)
+		assert.True(t, initial.HasNext)
+
+		var streamed []streamedItems
+		for {
+			var resp incrementalResponse
+			require.NoError(t, sock.NextResponse(&resp))
+			streamed = append(streamed, resp.Incremental...)
+			if !resp.HasNext {
+				break
+			}
+		}
+
+		require.Equal(t, []streamedItems{
+			{
+				Items:   []any{map[string]any{"id": "3"}},
+				Path:    []any{"streamSlice", float64(2)},
+				HasNext: true,
+			},
+		}, streamed)
+	})
+}
@@ -117,7 +117,7 @@ func (ec *executionContext) _VariadicModel(ctx context.Context, sel ast.Selectio
 					}
 				}()
 				res = ec._VariadicModel_value(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				if res == graphql.RequiredNull && ec.PropagatesNulls() {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
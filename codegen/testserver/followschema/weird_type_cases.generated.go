@@ -199,7 +199,7 @@ func (ec *executionContext) _AIt(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("AIt")
 		case "id":
 			out.Values[i] = ec._AIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -237,7 +237,7 @@ func (ec *executionContext) _AbIt(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("AbIt")
 		case "id":
 			out.Values[i] = ec._AbIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -275,7 +275,7 @@ func (ec *executionContext) _XXIt(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("XXIt")
 		case "id":
 			out.Values[i] = ec._XXIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -313,7 +313,7 @@ func (ec *executionContext) _XxIt(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("XxIt")
 		case "id":
 			out.Values[i] = ec._XxIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -351,7 +351,7 @@ func (ec *executionContext) _asdfIt(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = graphql.MarshalString("asdfIt")
 		case "id":
 			out.Values[i] = ec._asdfIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
@@ -389,7 +389,7 @@ func (ec *executionContext) _iIt(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("iIt")
 		case "id":
 			out.Values[i] = ec._iIt_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			if out.Values[i] == graphql.Null && ec.PropagatesNulls() {
 				out.Invalids++
 			}
 		default:
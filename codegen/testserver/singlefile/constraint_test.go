@@ -0,0 +1,117 @@
+package singlefile
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestConstraint(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.ConstrainedOrder = func(
+		ctx context.Context,
+		customer string,
+		input ConstrainedOrderInput,
+	) (string, error) {
+		return fmt.Sprintf("%s ordered %d items", customer, len(input.Items)), nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	query := `query($customer: String!, $input: ConstrainedOrderInput!) {
+		constrainedOrder(customer: $customer, input: $input)
+	}`
+
+	t.Run("valid values", func(t *testing.T) {
+		var resp struct{ ConstrainedOrder string }
+		c.MustPost(query, &resp,
+			client.Var("customer", "Ann"),
+			client.Var("input", map[string]any{
+				"email":     "ann@example.com",
+				"website":   "https://example.com",
+				"reference": "123e4567-e89b-12d3-a456-426614174000",
+				"items":     []map[string]any{{"name": "Tea", "quantity": 2}},
+				"tags":      []string{"gift"},
+			}))
+		require.Equal(t, "Ann ordered 1 items", resp.ConstrainedOrder)
+	})
+
+	t.Run("all violations are reported at once", func(t *testing.T) {
+		resp, err := c.RawPost(query,
+			client.Var("customer", "A"),
+			client.Var("input", map[string]any{
+				"email":     "ann",
+				"website":   "example.com",
+				"reference": "123",
+				"items": []map[string]any{
+					{"name": "Tea", "quantity": 2},
+					{"name": "Tea 42", "quantity": 0},
+				},
+				"tags": []string{"ok", "NOT", "ok"},
+			}))
+		require.NoError(t, err)
+		require.Nil(t, resp.Data)
+		require.JSONEq(t, `[
+			{
+				"message": "must be at least 2 characters long",
+				"path": ["constrainedOrder", "customer"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must be a valid email",
+				"path": ["constrainedOrder", "input", "email"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must be a valid uri",
+				"path": ["constrainedOrder", "input", "website"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must be a valid uuid",
+				"path": ["constrainedOrder", "input", "reference"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must match the pattern ^[A-Za-z ]+$",
+				"path": ["constrainedOrder", "input", "items", 1, "name"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must be greater than or equal to 1",
+				"path": ["constrainedOrder", "input", "items", 1, "quantity"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must have at most 2 items",
+				"path": ["constrainedOrder", "input", "tags"],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			},
+			{
+				"message": "must match the pattern ^[a-z]+$",
+				"path": ["constrainedOrder", "input", "tags", 1],
+				"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+			}
+		]`, string(resp.Errors))
+	})
+
+	t.Run("list sizes", func(t *testing.T) {
+		resp, err := c.RawPost(query,
+			client.Var("customer", "Ann"),
+			client.Var("input", map[string]any{"email": "ann@example.com", "items": []any{}}))
+		require.NoError(t, err)
+		require.JSONEq(t, `[{
+			"message": "must have at least 1 items",
+			"path": ["constrainedOrder", "input", "items"],
+			"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+		}]`, string(resp.Errors))
+	})
+}
@@ -597,110 +597,86 @@ fragment ChildFragment on DeferModel {
 		},
 	}
 
-	for _, tc := range cases {
-		t.Run(tc.name+"/over SSE", func(t *testing.T) {
-			synctest.Test(t, func(t *testing.T) {
-				resT := reflect.TypeOf(tc.expectedInitialResponse)
-				resE := reflect.New(resT).Elem()
-				resp := resE.Interface()
-
-				read := c.SSE(context.Background(), tc.query)
-				synctest.Wait()
-				require.NoError(t, read.Next(&resp))
-				assert.Equal(
-					t,
-					tc.expectedInitialResponse,
-					resp,
-					"expected initial response to match",
-				)
-
-				// If there are no deferred responses, we can stop here.
-				if !resE.FieldByName("HasNext").Bool() && len(tc.expectedDeferredResponses) == 0 {
-					return
-				}
-
-				deferredResponses := make([]deferredData, 0)
-				for {
-					var valueResp deferredData
-					synctest.Wait()
-					require.NoError(t, read.Next(&valueResp))
-					deferredResponses = append(deferredResponses, valueResp)
-					if !valueResp.HasNext {
-						break
-					}
-				}
-				require.NoError(t, read.Close(), "expected to close reader")
-
-				if tc.assertResponses != nil {
-					tc.assertResponses(t, &tc, deferredResponses)
-				} else {
-					assert.Equal(
-						t,
-						tc.expectedDeferredResponses,
-						deferredResponses,
-						"expected deferred responses to match",
-					)
-				}
-			})
-		})
-
-		t.Run(tc.name+"/over multipart HTTP", func(t *testing.T) {
-			synctest.Test(t, func(t *testing.T) {
-				resT := reflect.TypeOf(tc.expectedInitialResponse)
-				resE := reflect.New(resT).Elem()
-				resp := resE.Interface()
-
-				read := c.IncrementalHTTP(context.Background(), tc.query)
+	transports := []struct {
+		name string
+		open func(query string) (next func(response any) error, close func() error)
+	}{
+		{
+			name: "over SSE",
+			open: func(query string) (func(response any) error, func() error) {
+				read := c.SSE(context.Background(), query)
+				return read.Next, read.Close
+			},
+		},
+		{
+			name: "over multipart HTTP",
+			open: func(query string) (func(response any) error, func() error) {
+				read := c.IncrementalHTTP(context.Background(), query)
+				return read.Next, read.Close
+			},
+		},
+	}
 
-				synctest.Wait()
-				require.NoError(t, read.Next(&resp))
-				assert.Equal(t, tc.expectedInitialResponse, resp)
+	for _, tc := range cases {
+		for _, tr := range transports {
+			t.Run(tc.name+"/"+tr.name, func(t *testing.T) {
+				synctest.Test(t, func(t *testing.T) {
+					resT := reflect.TypeOf(tc.expectedInitialResponse)
+					resE := reflect.New(resT).Elem()
+					resp := resE.Interface()
 
-				// If there are no deferred responses, we can stop here.
-				if !reflect.ValueOf(resp).FieldByName("HasNext").Bool() &&
-					len(tc.expectedDeferredResponses) == 0 {
-					return
-				}
+					next, closeRead := tr.open(tc.query)
 
-				deferredIncrementalData := make([]deferredData, 0)
-				for {
-					var valueResp incrementalDeferredResponse
 					synctest.Wait()
-					require.NoError(t, read.Next(&valueResp))
-					assert.Empty(t, valueResp.Errors)
-					assert.Empty(t, valueResp.Extensions)
+					require.NoError(t, next(&resp))
+					assert.Equal(t, tc.expectedInitialResponse, resp)
 
-					// Extract the incremental data from the response.
-					//
-					// FIXME: currently the HasNext field does not describe the state of the
-					// delivery as bounded by the associated path, but rather the state of
-					// the operation as a whole. This makes it impossible to determine it
-					// from the response, so we can not define it ahead of time.
-					//
-					// It is also questionable that the incremental data objects should
-					// include hasNext, so for now we remove them from assertion. Once we
-					// align on the spec we must update this test, as the status of the
-					// path-bounded delivery should be determinative and can be asserted.
-					deferredIncrementalData = append(
-						deferredIncrementalData,
-						valueResp.Incremental...)
+					// If there are no deferred responses, we can stop here.
+					if !reflect.ValueOf(resp).FieldByName("HasNext").Bool() &&
+						len(tc.expectedDeferredResponses) == 0 {
+						return
+					}
 
-					if !valueResp.HasNext {
-						break
+					deferredIncrementalData := make([]deferredData, 0)
+					for {
+						var valueResp incrementalDeferredResponse
+						synctest.Wait()
+						require.NoError(t, next(&valueResp))
+						assert.Empty(t, valueResp.Errors)
+						assert.Empty(t, valueResp.Extensions)
+
+						// Extract the incremental data from the response.
+						//
+						// FIXME: currently the HasNext field does not describe the state of the
+						// delivery as bounded by the associated path, but rather the state of
+						// the operation as a whole. This makes it impossible to determine it
+						// from the response, so we can not define it ahead of time.
+						//
+						// It is also questionable that the incremental data objects should
+						// include hasNext, so for now we remove them from assertion. Once we
+						// align on the spec we must update this test, as the status of the
+						// path-bounded delivery should be determinative and can be asserted.
+						deferredIncrementalData = append(
+							deferredIncrementalData,
+							valueResp.Incremental...)
+
+						if !valueResp.HasNext {
+							break
+						}
+					}
+					require.NoError(t, closeRead())
+					if tc.assertResponses != nil {
+						tc.assertResponses(t, &tc, deferredIncrementalData)
+					} else {
+						assert.Equal(
+							t,
+							tc.expectedDeferredResponses,
+							deferredIncrementalData,
+							"expected deferred responses to match",
+						)
 					}
-				}
-				require.NoError(t, read.Close())
-				if tc.assertResponses != nil {
-					tc.assertResponses(t, &tc, deferredIncrementalData)
-				} else {
-					assert.Equal(
-						t,
-						tc.expectedDeferredResponses,
-						deferredIncrementalData,
-						"expected deferred responses to match",
-					)
-				}
+				})
 			})
-		})
+		}
 	}
 }
@@ -0,0 +1,56 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSemanticNonNull(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.SemanticNonNull = func(ctx context.Context) (*SemanticNonNull, error) {
+		return &SemanticNonNull{
+			Name:    "a",
+			Tags:    []string{"x"},
+			Friends: []*SemanticNonNull{nil, {Name: "b"}},
+		}, nil
+	}
+	resolvers.QueryResolver.SemanticNonNullName = func(ctx context.Context) (string, error) {
+		return "name", nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("non-null Go types", func(t *testing.T) {
+		resp, err := c.RawPost(`{ semanticNonNullName semanticNonNull { name tags } }`)
+		require.NoError(t, err)
+		require.Nil(t, resp.Errors)
+		require.Equal(t, map[string]any{
+			"semanticNonNullName": "name",
+			"semanticNonNull":     map[string]any{"name": "a", "tags": []any{"x"}},
+		}, resp.Data)
+	})
+
+	t.Run("nil values are errors that do not propagate", func(t *testing.T) {
+		resp, err := c.RawPost(`{ semanticNonNull { name friend { name } friends { name } } }`)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"semanticNonNull": map[string]any{
+				"name":    "a",
+				"friend":  nil,
+				"friends": []any{nil, map[string]any{"name": "b"}},
+			},
+		}, resp.Data)
+		require.JSONEq(t, `[
+			{"message":"must not be null","path":["semanticNonNull","friend"]},
+			{"message":"must not be null","path":["semanticNonNull","friends",0]}
+		]`, string(resp.Errors))
+	})
+}
@@ -4,6 +4,7 @@ package singlefile
 
 import (
 	"context"
+	"iter"
 
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/singlefile/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/singlefile/invalid-packagename"
@@ -14,6 +15,9 @@ type Stub struct {
 	BackedByInterfaceResolver struct {
 		ID func(ctx context.Context, obj BackedByInterface) (string, error)
 	}
+	CachedUserResolver struct {
+		Score func(ctx context.Context, obj *CachedUser) (int, error)
+	}
 	DeferModelResolver struct {
 		OtherResolvedValue func(ctx context.Context, obj *DeferModel) (string, error)
 		Values             func(ctx context.Context, obj *DeferModel) ([]string, error)
@@ -73,7 +77,10 @@ type Stub struct {
 		Autobind                         func(ctx context.Context) (*Autobind, error)
 		DeprecatedField                  func(ctx context.Context) (string, error)
 		FieldWithDeprecatedArg           func(ctx context.Context, oldArg *int, newArg *int) (*string, error)
+		CachedRate                       func(ctx context.Context, currency string) (float64, error)
+		CachedUsers                      func(ctx context.Context) ([]*CachedUser, error)
 		Overlapping                      func(ctx context.Context) (*OverlappingFields, error)
+		ConstrainedOrder                 func(ctx context.Context, customer string, input ConstrainedOrderInput) (string, error)
 		DefaultParameters                func(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error)
 		DeferSingle                      func(ctx context.Context) (*DeferModel, error)
 		DeferMultiple                    func(ctx context.Context) ([]*DeferModel, error)
@@ -120,6 +127,8 @@ type Stub struct {
 		Errors                           func(ctx context.Context) (*Errors, error)
 		Valid                            func(ctx context.Context) (string, error)
 		Invalid                          func(ctx context.Context) (string, error)
+		OneOfPet                         func(ctx context.Context, input OneOfPetInput) (string, error)
+		OneOfOwner                       func(ctx context.Context, input OneOfOwnerInput) (string, error)
 		Panics                           func(ctx context.Context) (*Panics, error)
 		PrimitiveObject                  func(ctx context.Context) ([]Primitive, error)
 		PrimitiveStringObject            func(ctx context.Context) ([]PrimitiveString, error)
@@ -129,9 +138,14 @@ type Stub struct {
 		StringFromContextInterface       func(ctx context.Context) (*StringFromContextInterface, error)
 		StringFromContextFunction        func(ctx context.Context) (string, error)
 		DefaultScalar                    func(ctx context.Context, arg string) (string, error)
+		SemanticNonNull                  func(ctx context.Context) (*SemanticNonNull, error)
+		SemanticNonNullName              func(ctx context.Context) (string, error)
 		SkipInclude                      func(ctx context.Context) (*SkipIncludeTestType, error)
 		Slices                           func(ctx context.Context) (*Slices, error)
 		ScalarSlice                      func(ctx context.Context) ([]byte, error)
+		StreamSlice                      func(ctx context.Context) ([]*StreamItem, error)
+		StreamSeq                        func(ctx context.Context) (iter.Seq[*StreamItem], error)
+		StreamChan                       func(ctx context.Context) (<-chan *string, error)
 		Fallback                         func(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error)
 		OptionalUnion                    func(ctx context.Context) (TestUnion, error)
 		VOkCaseValue                     func(ctx context.Context) (*VOkCaseValue, error)
@@ -143,6 +157,9 @@ type Stub struct {
 		WrappedMap                       func(ctx context.Context) (WrappedMap, error)
 		WrappedSlice                     func(ctx context.Context) (WrappedSlice, error)
 	}
+	StreamItemResolver struct {
+		Name func(ctx context.Context, obj *StreamItem) (string, error)
+	}
 	SubscriptionResolver struct {
 		Updated                func(ctx context.Context) (<-chan string, error)
 		InitPayload            func(ctx context.Context) (<-chan string, error)
@@ -172,6 +189,9 @@ type Stub struct {
 func (r *Stub) BackedByInterface() BackedByInterfaceResolver {
 	return &stubBackedByInterface{r}
 }
+func (r *Stub) CachedUser() CachedUserResolver {
+	return &stubCachedUser{r}
+}
 func (r *Stub) DeferModel() DeferModelResolver {
 	return &stubDeferModel{r}
 }
@@ -205,6 +225,9 @@ func (r *Stub) PrimitiveString() PrimitiveStringResolver {
 func (r *Stub) Query() QueryResolver {
 	return &stubQuery{r}
 }
+func (r *Stub) StreamItem() StreamItemResolver {
+	return &stubStreamItem{r}
+}
 func (r *Stub) Subscription() SubscriptionResolver {
 	return &stubSubscription{r}
 }
@@ -228,6 +251,12 @@ func (r *stubBackedByInterface) ID(ctx context.Context, obj BackedByInterface) (
 	return r.BackedByInterfaceResolver.ID(ctx, obj)
 }
 
+type stubCachedUser struct{ *Stub }
+
+func (r *stubCachedUser) Score(ctx context.Context, obj *CachedUser) (int, error) {
+	return r.CachedUserResolver.Score(ctx, obj)
+}
+
 type stubDeferModel struct{ *Stub }
 
 func (r *stubDeferModel) OtherResolvedValue(ctx context.Context, obj *DeferModel) (string, error) {
@@ -374,9 +403,18 @@ func (r *stubQuery) DeprecatedField(ctx context.Context) (string, error) {
 func (r *stubQuery) FieldWithDeprecatedArg(ctx context.Context, oldArg *int, newArg *int) (*string, error) {
 	return r.QueryResolver.FieldWithDeprecatedArg(ctx, oldArg, newArg)
 }
+func (r *stubQuery) CachedRate(ctx context.Context, currency string) (float64, error) {
+	return r.QueryResolver.CachedRate(ctx, currency)
+}
+func (r *stubQuery) CachedUsers(ctx context.Context) ([]*CachedUser, error) {
+	return r.QueryResolver.CachedUsers(ctx)
+}
 func (r *stubQuery) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	return r.QueryResolver.Overlapping(ctx)
 }
+func (r *stubQuery) ConstrainedOrder(ctx context.Context, customer string, input ConstrainedOrderInput) (string, error) {
+	return r.QueryResolver.ConstrainedOrder(ctx, customer, input)
+}
 func (r *stubQuery) DefaultParameters(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error) {
 	return r.QueryResolver.DefaultParameters(ctx, falsyBoolean, truthyBoolean)
 }
@@ -515,6 +553,12 @@ func (r *stubQuery) Valid(ctx context.Context) (string, error) {
 func (r *stubQuery) Invalid(ctx context.Context) (string, error) {
 	return r.QueryResolver.Invalid(ctx)
 }
+func (r *stubQuery) OneOfPet(ctx context.Context, input OneOfPetInput) (string, error) {
+	return r.QueryResolver.OneOfPet(ctx, input)
+}
+func (r *stubQuery) OneOfOwner(ctx context.Context, input OneOfOwnerInput) (string, error) {
+	return r.QueryResolver.OneOfOwner(ctx, input)
+}
 func (r *stubQuery) Panics(ctx context.Context) (*Panics, error) {
 	return r.QueryResolver.Panics(ctx)
 }
@@ -542,6 +586,12 @@ func (r *stubQuery) StringFromContextFunction(ctx context.Context) (string, erro
 func (r *stubQuery) DefaultScalar(ctx context.Context, arg string) (string, error) {
 	return r.QueryResolver.DefaultScalar(ctx, arg)
 }
+func (r *stubQuery) SemanticNonNull(ctx context.Context) (*SemanticNonNull, error) {
+	return r.QueryResolver.SemanticNonNull(ctx)
+}
+func (r *stubQuery) SemanticNonNullName(ctx context.Context) (string, error) {
+	return r.QueryResolver.SemanticNonNullName(ctx)
+}
 func (r *stubQuery) SkipInclude(ctx context.Context) (*SkipIncludeTestType, error) {
 	return r.QueryResolver.SkipInclude(ctx)
 }
@@ -551,6 +601,15 @@ func (r *stubQuery) Slices(ctx context.Context) (*Slices, error) {
 func (r *stubQuery) ScalarSlice(ctx context.Context) ([]byte, error) {
 	return r.QueryResolver.ScalarSlice(ctx)
 }
+func (r *stubQuery) StreamSlice(ctx context.Context) ([]*StreamItem, error) {
+	return r.QueryResolver.StreamSlice(ctx)
+}
+func (r *stubQuery) StreamSeq(ctx context.Context) (iter.Seq[*StreamItem], error) {
+	return r.QueryResolver.StreamSeq(ctx)
+}
+func (r *stubQuery) StreamChan(ctx context.Context) (<-chan *string, error) {
+	return r.QueryResolver.StreamChan(ctx)
+}
 func (r *stubQuery) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	return r.QueryResolver.Fallback(ctx, arg)
 }
@@ -582,6 +641,12 @@ func (r *stubQuery) WrappedSlice(ctx context.Context) (WrappedSlice, error) {
 	return r.QueryResolver.WrappedSlice(ctx)
 }
 
+type stubStreamItem struct{ *Stub }
+
+func (r *stubStreamItem) Name(ctx context.Context, obj *StreamItem) (string, error) {
+	return r.StreamItemResolver.Name(ctx, obj)
+}
+
 type stubSubscription struct{ *Stub }
 
 func (r *stubSubscription) Updated(ctx context.Context) (<-chan string, error) {
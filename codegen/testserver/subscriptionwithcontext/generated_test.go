@@ -155,3 +155,57 @@ func TestSubscriptionContext_UnmarkedFieldKeepsSubscriptionCtx(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscriptionContext_ResumesFromLastEventID(t *testing.T) {
+	events := graphql.NewReplayBuffer[string](10)
+	for _, v := range []string{"a", "b", "c"} {
+		events.Publish(v)
+	}
+
+	resolvers := &Stub{}
+	resolvers.SubscriptionResolver.Marked = func(ctx context.Context) (<-chan graphql.Event[string], error) {
+		missed, ok := events.Since(transport.GetLastEventID(ctx))
+		if !ok {
+			// new subscribers start after the first event in this test
+			missed, _ = events.Since("0")
+		}
+		ch := make(chan graphql.Event[string], len(missed))
+		for _, ev := range missed {
+			ch <- ev
+		}
+		close(ch)
+		return ch, nil
+	}
+
+	c := newTestServer(t, resolvers, &observedContexts{})
+
+	type response struct {
+		Data struct {
+			Marked string
+		}
+		HasNext bool `json:"hasNext"`
+	}
+
+	read := c.SSE(context.Background(), `subscription { marked }`)
+	var resp response
+	if err := read.Next(&resp); err != nil {
+		t.Fatalf("SSE Next failed: %v", err)
+	}
+	if resp.Data.Marked != "b" || read.LastEventID() != "1" {
+		t.Fatalf("expected b with id 1, got %q with id %q", resp.Data.Marked, read.LastEventID())
+	}
+	read.Close()
+
+	read = c.SSE(
+		context.Background(),
+		`subscription { marked }`,
+		client.AddHeader("Last-Event-ID", read.LastEventID()),
+	)
+	defer read.Close()
+	if err := read.Next(&resp); err != nil {
+		t.Fatalf("SSE Next failed after resuming: %v", err)
+	}
+	if resp.Data.Marked != "c" || read.LastEventID() != "2" {
+		t.Fatalf("expected c with id 2, got %q with id %q", resp.Data.Marked, read.LastEventID())
+	}
+}
@@ -0,0 +1,116 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type recordingFieldResultCache struct {
+	keys []string
+	ttl  time.Duration
+}
+
+func (c *recordingFieldResultCache) Resolve(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	next Resolver,
+) (any, error) {
+	c.keys = append(c.keys, key)
+	c.ttl = ttl
+	return next(ctx)
+}
+
+type cachedUser struct {
+	ID   string
+	Name string `json:"displayName"`
+}
+
+func (u *cachedUser) GetSlug() string {
+	return "user-" + u.ID
+}
+
+func TestCachedDirective(t *testing.T) {
+	next := func(ctx context.Context) (any, error) {
+		return "result", nil
+	}
+	fieldCtx := func(args map[string]any) context.Context {
+		return WithFieldContext(context.Background(), &FieldContext{
+			Object: "User",
+			Args:   args,
+			Field:  CollectedField{Field: &ast.Field{Name: "rates"}},
+		})
+	}
+	ptr := func(s string) *string { return &s }
+
+	t.Run("calls the resolver without a cache", func(t *testing.T) {
+		res, err := CachedDirective(fieldCtx(nil), nil, next, 60, nil)
+		require.NoError(t, err)
+		require.Equal(t, "result", res)
+	})
+
+	tests := []struct {
+		name     string
+		obj      any
+		args     map[string]any
+		keyField *string
+		key      string
+	}{
+		{
+			name: "keys by coordinate and arguments",
+			args: map[string]any{"to": "EUR", "from": "USD"},
+			key:  `User.rates{"from":"USD","to":"EUR"}`,
+		},
+		{
+			name:     "keys by struct field",
+			obj:      &cachedUser{ID: "1"},
+			keyField: ptr("id"),
+			key:      `User.rates{}:1`,
+		},
+		{
+			name:     "keys by json name",
+			obj:      cachedUser{Name: "bob"},
+			keyField: ptr("displayName"),
+			key:      `User.rates{}:bob`,
+		},
+		{
+			name:     "keys by getter",
+			obj:      &cachedUser{ID: "2"},
+			keyField: ptr("slug"),
+			key:      `User.rates{}:user-2`,
+		},
+		{
+			name:     "keys by map entry",
+			obj:      map[string]any{"id": 3},
+			keyField: ptr("id"),
+			key:      `User.rates{}:3`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.args == nil {
+				tc.args = map[string]any{}
+			}
+			cache := &recordingFieldResultCache{}
+			ctx := WithFieldResultCache(fieldCtx(tc.args), cache)
+
+			res, err := CachedDirective(ctx, tc.obj, next, 60, tc.keyField)
+			require.NoError(t, err)
+			require.Equal(t, "result", res)
+			require.Equal(t, []string{tc.key}, cache.keys)
+			require.Equal(t, time.Minute, cache.ttl)
+		})
+	}
+
+	t.Run("fails for unknown key fields", func(t *testing.T) {
+		ctx := WithFieldResultCache(fieldCtx(nil), &recordingFieldResultCache{})
+		_, err := CachedDirective(ctx, &cachedUser{}, next, 60, ptr("missing"))
+		require.EqualError(t, err,
+			`unable to build cache key for User.rates: no field "missing" on *graphql.cachedUser`)
+	})
+}
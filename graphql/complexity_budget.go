@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+const complexityBudgetCtx key = "complexity_budget"
+
+type complexityBudget struct {
+	mu        sync.Mutex
+	remaining int
+}
+
+// WithComplexityBudget returns a context in which the operations share a complexity budget of
+// limit, e.g. the operations of an HTTP batch. The ComplexityLimit extension spends the
+// complexity of each operation from it and rejects the operations that exceed what remains.
+func WithComplexityBudget(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, complexityBudgetCtx, &complexityBudget{remaining: limit})
+}
+
+// SpendComplexityBudget spends complexity from the budget of ctx, unless it exceeds the
+// remaining budget, which it returns. It always succeeds when ctx has no budget.
+func SpendComplexityBudget(ctx context.Context, complexity int) (remaining int, ok bool) {
+	budget, found := ctx.Value(complexityBudgetCtx).(*complexityBudget)
+	if !found {
+		return 0, true
+	}
+
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	if complexity > budget.remaining {
+		return budget.remaining, false
+	}
+	budget.remaining -= complexity
+	return budget.remaining, true
+}
@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplexityBudget(t *testing.T) {
+	remaining, ok := SpendComplexityBudget(context.Background(), 100)
+	require.True(t, ok)
+	require.Zero(t, remaining)
+
+	ctx := WithComplexityBudget(context.Background(), 5)
+
+	remaining, ok = SpendComplexityBudget(ctx, 3)
+	require.True(t, ok)
+	require.Equal(t, 2, remaining)
+
+	remaining, ok = SpendComplexityBudget(ctx, 3)
+	require.False(t, ok)
+	require.Equal(t, 2, remaining)
+
+	remaining, ok = SpendComplexityBudget(ctx, 2)
+	require.True(t, ok)
+	require.Zero(t, remaining)
+}
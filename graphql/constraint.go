@@ -0,0 +1,299 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// ConstraintDirective is the name of the directive validating arguments and input fields:
+//
+//	directive @constraint(
+//	  minLength: Int
+//	  maxLength: Int
+//	  pattern: String
+//	  min: Float
+//	  max: Float
+//	  format: String
+//	  minItems: Int
+//	  maxItems: Int
+//	) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+//
+// gqlgen generates the validation of the values right after unmarshaling them.
+const ConstraintDirective = "constraint"
+
+// IsConstraintFormat reports whether name is a format supported by the format argument of
+// @constraint: email, uri or uuid.
+func IsConstraintFormat(name string) bool {
+	switch name {
+	case "email", "uri", "uuid":
+		return true
+	}
+	return false
+}
+
+// ConstraintRule is a rule of the @constraint directive. Rules on the length, pattern, format or
+// range of a value apply to each item of lists, while MinItems and MaxItems apply to the lists.
+type ConstraintRule struct {
+	list  bool
+	check func(v reflect.Value) string
+}
+
+// MinLength requires strings to have at least n characters.
+func MinLength(n int) ConstraintRule {
+	return stringRule(func(s string) string {
+		if utf8.RuneCountInString(s) < n {
+			return fmt.Sprintf("must be at least %d characters long", n)
+		}
+		return ""
+	})
+}
+
+// MaxLength requires strings to have at most n characters.
+func MaxLength(n int) ConstraintRule {
+	return stringRule(func(s string) string {
+		if utf8.RuneCountInString(s) > n {
+			return fmt.Sprintf("must be at most %d characters long", n)
+		}
+		return ""
+	})
+}
+
+// Pattern requires strings to match the regular expression expr, which panics if expr does not
+// compile. gqlgen checks the patterns of the schema when generating code, and the generated code
+// builds its rules once, in package level variables.
+func Pattern(expr string) ConstraintRule {
+	re := regexp.MustCompile(expr)
+	return stringRule(func(s string) string {
+		if !re.MatchString(s) {
+			return fmt.Sprintf("must match the pattern %s", expr)
+		}
+		return ""
+	})
+}
+
+// Format requires strings to be of the format name. Formats not supported by IsConstraintFormat
+// are ignored.
+func Format(name string) ConstraintRule {
+	return stringRule(func(s string) string {
+		valid := true
+		switch name {
+		case "email":
+			addr, err := mail.ParseAddress(s)
+			valid = err == nil && addr.Address == s
+		case "uri":
+			u, err := url.Parse(s)
+			valid = err == nil && u.Scheme != ""
+		case "uuid":
+			valid = isUUID(s)
+		}
+		if !valid {
+			return "must be a valid " + name
+		}
+		return ""
+	})
+}
+
+// Min requires numbers to be greater than or equal to n.
+func Min(n float64) ConstraintRule {
+	return numberRule(func(f float64) string {
+		if f < n {
+			return fmt.Sprintf("must be greater than or equal to %v", n)
+		}
+		return ""
+	})
+}
+
+// Max requires numbers to be less than or equal to n.
+func Max(n float64) ConstraintRule {
+	return numberRule(func(f float64) string {
+		if f > n {
+			return fmt.Sprintf("must be less than or equal to %v", n)
+		}
+		return ""
+	})
+}
+
+// MinItems requires lists to have at least n items.
+func MinItems(n int) ConstraintRule {
+	return ConstraintRule{list: true, check: func(v reflect.Value) string {
+		if v.Kind() == reflect.Slice && v.Len() < n {
+			return fmt.Sprintf("must have at least %d items", n)
+		}
+		return ""
+	}}
+}
+
+// MaxItems requires lists to have at most n items.
+func MaxItems(n int) ConstraintRule {
+	return ConstraintRule{list: true, check: func(v reflect.Value) string {
+		if v.Kind() == reflect.Slice && v.Len() > n {
+			return fmt.Sprintf("must have at most %d items", n)
+		}
+		return ""
+	}}
+}
+
+// ValidateConstraint checks value, unmarshaled at the path of ctx, against rules. Null values
+// satisfy every rule.
+//
+// Violations are recorded in the context set up by WithConstraintViolations, so that all the
+// violations of the arguments of a field are reported at once, and ValidateConstraint returns
+// nil. Without it, the violations are returned as a gqlerror.List.
+func ValidateConstraint(ctx context.Context, value any, rules ...ConstraintRule) error {
+	var errs gqlerror.List
+	validateConstraint(GetPath(ctx), reflect.ValueOf(value), rules, true, &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	if c, ok := ctx.Value(constraintViolationsCtx).(*constraintViolations); ok {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.errs = append(c.errs, errs...)
+		return nil
+	}
+	return errs
+}
+
+// UnmarshalConstrained unmarshals v, the raw value of an argument or input field, with unmarshal,
+// and checks the result against rules with ValidateConstraint. It is called from generated code.
+func UnmarshalConstrained[T any](
+	ctx context.Context,
+	v any,
+	unmarshal func(ctx context.Context, v any) (T, error),
+	rules ...ConstraintRule,
+) (T, error) {
+	res, err := unmarshal(ctx, v)
+	if err != nil {
+		return res, err
+	}
+	return res, ValidateConstraint(ctx, res, rules...)
+}
+
+// WithConstraintViolations returns a context in which ValidateConstraint records violations
+// rather than returning them. ConstraintViolations returns them.
+func WithConstraintViolations(ctx context.Context) context.Context {
+	return context.WithValue(ctx, constraintViolationsCtx, &constraintViolations{})
+}
+
+// ConstraintViolations returns the violations recorded by ValidateConstraint in ctx as a
+// gqlerror.List, or nil when there are none.
+func ConstraintViolations(ctx context.Context) error {
+	c, ok := ctx.Value(constraintViolationsCtx).(*constraintViolations)
+	if !ok {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return c.errs
+}
+
+const constraintViolationsCtx key = "constraint_violations_context"
+
+type constraintViolations struct {
+	mu   sync.Mutex
+	errs gqlerror.List
+}
+
+func validateConstraint(
+	path ast.Path,
+	v reflect.Value,
+	rules []ConstraintRule,
+	outer bool,
+	errs *gqlerror.List,
+) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || (v.Kind() == reflect.Slice && v.IsNil()) {
+		return
+	}
+
+	isList := v.Kind() == reflect.Slice
+	for _, rule := range rules {
+		// MinItems and MaxItems only apply to the outer list of nested lists
+		if rule.list != isList || (rule.list && !outer) {
+			continue
+		}
+		if msg := rule.check(v); msg != "" {
+			*errs = append(*errs, validationError(path, msg))
+		}
+	}
+
+	if isList {
+		for i := range v.Len() {
+			validateConstraint(appendPath(path, ast.PathIndex(i)), v.Index(i), rules, false, errs)
+		}
+	}
+}
+
+func validationError(path ast.Path, msg string) *gqlerror.Error {
+	err := gqlerror.ErrorPathf(path, "%s", msg)
+	errcode.Set(err, errcode.ValidationFailed)
+	return err
+}
+
+// appendPath returns a copy of path with elem appended, so that sibling paths do not share their
+// backing array.
+func appendPath(path ast.Path, elem ast.PathElement) ast.Path {
+	return append(path[:len(path):len(path)], elem)
+}
+
+func stringRule(check func(s string) string) ConstraintRule {
+	return ConstraintRule{check: func(v reflect.Value) string {
+		if v.Kind() != reflect.String {
+			return ""
+		}
+		return check(v.String())
+	}}
+}
+
+func numberRule(check func(f float64) string) ConstraintRule {
+	return ConstraintRule{check: func(v reflect.Value) string {
+		switch {
+		case v.CanInt():
+			return check(float64(v.Int()))
+		case v.CanUint():
+			return check(float64(v.Uint()))
+		case v.CanFloat():
+			return check(v.Float())
+		}
+		return ""
+	}}
+}
+
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, r := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+				return false
+			}
+		}
+	}
+	return true
+}
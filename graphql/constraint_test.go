@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestValidateConstraint(t *testing.T) {
+	ctx := WithPathContext(context.Background(), NewPathWithField("input"))
+	name := "Ann"
+
+	tests := []struct {
+		name     string
+		value    any
+		rules    []ConstraintRule
+		messages []string
+	}{
+		{name: "null", value: (*string)(nil), rules: []ConstraintRule{MinLength(1)}},
+		{name: "pointer", value: &name, rules: []ConstraintRule{MinLength(3), MaxLength(3)}},
+		{
+			name:  "length in characters",
+			value: "héé",
+			rules: []ConstraintRule{MinLength(4), MaxLength(2)},
+			messages: []string{
+				"must be at least 4 characters long",
+				"must be at most 2 characters long",
+			},
+		},
+		{
+			name:     "pattern",
+			value:    "abc1",
+			rules:    []ConstraintRule{Pattern(`^[a-z]+$`)},
+			messages: []string{"must match the pattern ^[a-z]+$"},
+		},
+		{
+			name:  "range",
+			value: []int{0, 5, 11},
+			rules: []ConstraintRule{Min(1), Max(10)},
+			messages: []string{
+				"must be greater than or equal to 1",
+				"must be less than or equal to 10",
+			},
+		},
+		{name: "float range", value: 1.5, rules: []ConstraintRule{Min(1), Max(1.5)}},
+		{
+			name:     "list items",
+			value:    []string{"a@b.c", "a b"},
+			rules:    []ConstraintRule{Format("email")},
+			messages: []string{"must be a valid email"},
+		},
+		{
+			name:     "items",
+			value:    [][]string{{"a"}, {"b", "c", "d"}},
+			rules:    []ConstraintRule{MinItems(3), MaxItems(2)},
+			messages: []string{"must have at least 3 items"},
+		},
+		{name: "rules of other kinds are ignored", value: 3, rules: []ConstraintRule{MinLength(5)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateConstraint(ctx, tc.value, tc.rules...)
+			if len(tc.messages) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs gqlerror.List
+			require.ErrorAs(t, err, &errs)
+			messages := make([]string, 0, len(errs))
+			for _, err := range errs {
+				messages = append(messages, err.Message)
+			}
+			require.Equal(t, tc.messages, messages)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		valid  []string
+		bad    []string
+	}{
+		{format: "email", valid: []string{"a@b.c"}, bad: []string{"a", "A <a@b.c>"}},
+		{format: "uri", valid: []string{"https://a.b/c", "urn:isbn:1"}, bad: []string{"a.b", "%"}},
+		{
+			format: "uuid",
+			valid:  []string{"123e4567-e89b-12d3-A456-426614174000"},
+			bad:    []string{"123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			require.True(t, IsConstraintFormat(tc.format))
+			for _, v := range tc.valid {
+				require.NoError(t, ValidateConstraint(context.Background(), v, Format(tc.format)), v)
+			}
+			for _, v := range tc.bad {
+				require.Error(t, ValidateConstraint(context.Background(), v, Format(tc.format)), v)
+			}
+		})
+	}
+	require.False(t, IsConstraintFormat("date"))
+}
+
+func TestConstraintViolations(t *testing.T) {
+	ctx := WithConstraintViolations(context.Background())
+	require.NoError(t, ConstraintViolations(ctx))
+
+	a := WithPathContext(ctx, NewPathWithField("a"))
+	require.NoError(t, ValidateConstraint(a, "", MinLength(1)))
+	b := WithPathContext(ctx, NewPathWithField("b"))
+	require.NoError(t, ValidateConstraint(b, []string{"x", ""}, MinLength(1)))
+
+	var errs gqlerror.List
+	require.ErrorAs(t, ConstraintViolations(ctx), &errs)
+	require.Len(t, errs, 2)
+	require.Equal(t, ast.Path{ast.PathName("a")}, errs[0].Path)
+	require.Equal(t, ast.Path{ast.PathName("b"), ast.PathIndex(1)}, errs[1].Path)
+	require.Equal(t, "GRAPHQL_VALIDATION_FAILED", errs[1].Extensions["code"])
+}
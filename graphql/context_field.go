@@ -36,6 +36,10 @@ type FieldContext struct {
 	// When true and the field resolves to nil, null propagation occurs
 	// as if the field were declared non-null (!) in the schema.
 	NonNull bool
+	// SemanticNonNullLevels are the levels of the field type marked as non-null by the
+	// @semanticNonNull directive, where 0 is the field itself and 1 the items of a list. The
+	// executor reports an error for each null value at these levels.
+	SemanticNonNullLevels []int
 	// Child allows getting a child FieldContext by its field collection description.
 	// Note that, the returned child FieldContext represents the context as it was
 	// before the execution of the field resolver. For example:
@@ -29,6 +29,24 @@ type OperationContext struct {
 
 	Stats Stats
 
+	// VisibleSchema, when set by an OperationParameterMutator, replaces the executable schema
+	// for validating and introspecting the operation, so parts of the schema can be hidden from
+	// some clients. VisibleSchemaKey must then identify it, as parsed operations are cached per
+	// schema.
+	VisibleSchema    *ast.Schema
+	VisibleSchemaKey string
+
+	// Sensitivity reports which values of the operation are marked @sensitive, so they can be
+	// redacted from logs and traces. It is nil when the schema does not declare @sensitive.
+	Sensitivity *Sensitivity
+
+	// OnError is the behavior of the executor on field errors, selected by the onError request
+	// extension or defaulting to DefaultOnError. The zero value behaves as ErrorBehaviorPropagate.
+	OnError ErrorBehavior
+	// DefaultOnError is the behavior of the server on field errors for requests not selecting
+	// one, reported by introspection as __Schema.defaultErrorBehavior.
+	DefaultOnError ErrorBehavior
+
 	collectFieldsCache collectFieldsCacheStore
 }
 
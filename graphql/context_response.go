@@ -14,6 +14,8 @@ type responseContext struct {
 
 	errors   gqlerror.List
 	errorsMu sync.Mutex
+	// halt, set by WithHaltOnError, cancels the operation when an error is added.
+	halt context.CancelFunc
 
 	extensions   map[string]any
 	extensionsMu sync.Mutex
@@ -45,6 +47,7 @@ func WithFreshResponseContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, resultCtx, &responseContext{
 		errorPresenter: e.errorPresenter,
 		recover:        e.recover,
+		halt:           e.halt,
 	})
 }
 
@@ -60,7 +63,8 @@ func AddError(ctx context.Context, err error) {
 	}
 	c := getResponseContext(ctx)
 
-	presentedError := c.errorPresenter(ctx, ErrorOnPath(ctx, err))
+	err = redactSensitiveError(ctx, ErrorOnPath(ctx, err))
+	presentedError := c.errorPresenter(ctx, err)
 	if presentedError == nil {
 		return
 	}
@@ -68,6 +72,9 @@ func AddError(ctx context.Context, err error) {
 	c.errorsMu.Lock()
 	defer c.errorsMu.Unlock()
 	c.errors = append(c.errors, presentedError)
+	if c.halt != nil {
+		c.halt()
+	}
 }
 
 func Recover(ctx context.Context, err any) (userMessage error) {
@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+)
+
+const responseHeaderCtx key = "response_header"
+
+// WithResponseHeader returns a context carrying the header map of the HTTP response that will
+// hold the result of the operation. HTTP transports that write a single response per request
+// call it with the header map of their http.ResponseWriter before creating the operation
+// context.
+func WithResponseHeader(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, responseHeaderCtx, header)
+}
+
+// GetResponseHeader returns the header map of the HTTP response for the current operation, so
+// extensions can set headers such as Cache-Control. It returns nil when the transport can not
+// send headers for the operation, for example on websockets, so check the result before using
+// it.
+//
+// Headers must be set before the transport writes the response: from an operation parameter or
+// context mutator, or from an operation or response interceptor before it returns the response.
+// The map is not safe for concurrent use, so do not write to it from field resolvers.
+func GetResponseHeader(ctx context.Context) http.Header {
+	h, _ := ctx.Value(responseHeaderCtx).(http.Header)
+	return h
+}
@@ -22,5 +22,8 @@ type DeferredResult struct {
 	Path   ast.Path
 	Label  string
 	Result Marshaler
+	// Items are the items of a list streamed with @stream, delivered instead of Result. A stream
+	// ends with a DeferredResult holding neither.
+	Items  Marshaler
 	Errors gqlerror.List
 }
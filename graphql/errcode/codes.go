@@ -1,6 +1,8 @@
 package errcode
 
 import (
+	"sync"
+
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -18,14 +20,22 @@ const (
 	KindUser
 )
 
-var codeType = map[string]ErrorKind{
-	ValidationFailed: KindProtocol,
-	ParseFailed:      KindProtocol,
-}
+var (
+	codeTypeMu sync.RWMutex
+	codeType   = map[string]ErrorKind{
+		ValidationFailed: KindProtocol,
+		ParseFailed:      KindProtocol,
+	}
+)
 
 // RegisterErrorType should be called by extensions that want to customize the http status codes for
 // errors they return
+//
+// Deprecated: the types registered here are shared by every server in the process. Register them
+// with the Registry of a server instead, or implement ErrorTypeProvider in the extension.
 func RegisterErrorType(code string, kind ErrorKind) {
+	codeTypeMu.Lock()
+	defer codeTypeMu.Unlock()
 	codeType[code] = kind
 }
 
@@ -47,14 +57,97 @@ func Set(err error, value string) {
 }
 
 // get the kind of the first non User error, defaults to User if no errors have a custom extension
+//
+// Deprecated: use Registry.Kind with the Registry of the server.
 func GetErrorKind(errs gqlerror.List) ErrorKind {
-	for _, err := range errs {
-		if code, ok := err.Extensions["code"].(string); ok {
-			if kind, ok := codeType[code]; ok && kind != KindUser {
-				return kind
-			}
+	return (*Registry)(nil).Kind(errs)
+}
+
+// ErrorType describes how transports report errors with a given code.
+type ErrorType struct {
+	// Kind is KindProtocol for errors rejecting the whole request, which are sent as error
+	// messages on websockets, and KindUser for errors sent alongside data.
+	Kind ErrorKind
+
+	// Status is the HTTP status code of responses with the error, such as 401, 403 or 429. When
+	// zero, requests rejected with KindProtocol errors get a 422, or a 400 for
+	// application/graphql-response+json responses, and other responses a 200.
+	Status int
+}
+
+// ErrorTypeProvider is implemented by handler extensions returning errors with their own codes.
+// The types it returns are registered with the Registry of the servers using the extension.
+type ErrorTypeProvider interface {
+	ErrorTypes() map[string]ErrorType
+}
+
+// Registry maps error codes to the way transports report them. Each server owns a Registry, so
+// servers in the same process can classify errors differently. It is safe for concurrent use, and
+// a nil *Registry only knows the types registered with the deprecated RegisterErrorType.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]ErrorType
+}
+
+// NewRegistry returns a Registry knowing the parse and validation error codes.
+func NewRegistry() *Registry {
+	return &Registry{types: map[string]ErrorType{
+		ValidationFailed: {Kind: KindProtocol},
+		ParseFailed:      {Kind: KindProtocol},
+	}}
+}
+
+// Register sets the type of errors with code.
+func (r *Registry) Register(code string, errorType ErrorType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[code] = errorType
+}
+
+// Lookup returns the type of errors with code, falling back to the types registered with the
+// deprecated RegisterErrorType.
+func (r *Registry) Lookup(code string) (ErrorType, bool) {
+	if r != nil {
+		r.mu.RLock()
+		errorType, ok := r.types[code]
+		r.mu.RUnlock()
+		if ok {
+			return errorType, true
 		}
 	}
 
+	codeTypeMu.RLock()
+	defer codeTypeMu.RUnlock()
+	kind, ok := codeType[code]
+	return ErrorType{Kind: kind}, ok
+}
+
+// Kind returns the kind of the first non User error, defaulting to KindUser if no error has a
+// code with another kind.
+func (r *Registry) Kind(errs gqlerror.List) ErrorKind {
+	for _, err := range errs {
+		if errorType, ok := r.lookupError(err); ok && errorType.Kind != KindUser {
+			return errorType.Kind
+		}
+	}
 	return KindUser
 }
+
+// Status returns the HTTP status code of the first error with a code registered with a Status,
+// or zero if there is none.
+func (r *Registry) Status(errs gqlerror.List) int {
+	for _, err := range errs {
+		if errorType, ok := r.lookupError(err); ok && errorType.Status != 0 {
+			return errorType.Status
+		}
+	}
+	return 0
+}
+
+func (r *Registry) lookupError(err *gqlerror.Error) (ErrorType, bool) {
+	code, ok := err.Extensions["code"].(string)
+	if !ok {
+		return ErrorType{}, false
+	}
+	return r.Lookup(code)
+}
@@ -0,0 +1,35 @@
+package errcode
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestRegistry(t *testing.T) {
+	withCode := func(code string) *gqlerror.Error {
+		err := gqlerror.Errorf("error")
+		Set(err, code)
+		return err
+	}
+
+	r := NewRegistry()
+	r.Register("FORBIDDEN", ErrorType{Kind: KindUser, Status: http.StatusForbidden})
+
+	require.Equal(t, KindProtocol, r.Kind(gqlerror.List{withCode(ValidationFailed)}))
+	require.Equal(t, KindUser, r.Kind(gqlerror.List{withCode("FORBIDDEN"), withCode("OTHER")}))
+	require.Equal(t, http.StatusForbidden, r.Status(gqlerror.List{withCode("FORBIDDEN")}))
+	require.Zero(t, r.Status(gqlerror.List{withCode(ValidationFailed), {Message: "no code"}}))
+
+	require.Equal(t, KindUser, NewRegistry().Kind(gqlerror.List{withCode("LEGACY")}))
+	RegisterErrorType("LEGACY", KindProtocol)
+	defer func() {
+		codeTypeMu.Lock()
+		delete(codeType, "LEGACY")
+		codeTypeMu.Unlock()
+	}()
+	require.Equal(t, KindProtocol, NewRegistry().Kind(gqlerror.List{withCode("LEGACY")}),
+		"types registered globally are still known")
+}
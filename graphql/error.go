@@ -35,6 +35,32 @@ func ErrorOnPath(ctx context.Context, err error) error {
 	return gqlerror.WrapPath(GetPath(ctx), err)
 }
 
+// CoercionErrorOnPath is ErrorOnPath for errors coercing an input value, such as a scalar or
+// enum argument that can not be unmarshaled. Coercion errors describe the input sent by the
+// client, so MaskingErrorPresenter shows them, and they are redacted for @sensitive inputs. It is
+// called from generated code.
+func CoercionErrorOnPath(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	return coercionError{ErrorOnPath(ctx, err)}
+}
+
+type coercionError struct {
+	error
+}
+
+func (e coercionError) Unwrap() error {
+	return e.error
+}
+
+// isCoercionError reports whether err, or an error it wraps, was returned by
+// CoercionErrorOnPath.
+func isCoercionError(err error) bool {
+	var coercion coercionError
+	return errors.As(err, &coercion)
+}
+
 // AddFieldLocationToError attaches the source location of the current field
 // to a resolver error. Called from resolveField after a resolver returns an error.
 // This ensures resolver errors include the locations field as required by the
@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrorBehavior controls what happens to the response when a field raises an error, as selected
+// per request by the onError request extension:
+//
+//	{"query": "...", "extensions": {"onError": "NULL"}}
+type ErrorBehavior string
+
+const (
+	// ErrorBehaviorPropagate sets the failing field to null and propagates the null to the
+	// closest nullable parent when the field is non-null.
+	ErrorBehaviorPropagate ErrorBehavior = "PROPAGATE"
+	// ErrorBehaviorNull sets the failing field to null, even when it is non-null, and keeps the
+	// rest of the response.
+	ErrorBehaviorNull ErrorBehavior = "NULL"
+	// ErrorBehaviorHalt aborts the operation at the first error, responding with null data and
+	// that error only.
+	ErrorBehaviorHalt ErrorBehavior = "HALT"
+)
+
+// OnErrorExtension is the key of the request extension selecting the ErrorBehavior of a request.
+const OnErrorExtension = "onError"
+
+// IsValid reports whether b is a known ErrorBehavior.
+func (b ErrorBehavior) IsValid() bool {
+	switch b {
+	case ErrorBehaviorPropagate, ErrorBehaviorNull, ErrorBehaviorHalt:
+		return true
+	}
+	return false
+}
+
+// ParseErrorBehavior returns the ErrorBehavior named by v, the value of the onError request
+// extension.
+func ParseErrorBehavior(v any) (ErrorBehavior, error) {
+	name, ok := v.(string)
+	if !ok || !ErrorBehavior(name).IsValid() {
+		return "", fmt.Errorf(
+			"invalid onError value %v, expected one of NULL, PROPAGATE or HALT", v)
+	}
+	return ErrorBehavior(name), nil
+}
+
+// PropagatesNulls reports whether null values of non-null fields and list items propagate to
+// their parent, which is the case unless the operation runs with ErrorBehaviorNull. Generated
+// code checks it before nulling out the parent of a null non-null value.
+func (c *OperationContext) PropagatesNulls() bool {
+	return c == nil || c.OnError != ErrorBehaviorNull
+}
+
+// WithHaltOnError returns a copy of ctx that is canceled as soon as an error is added to the
+// response context of ctx, so resolvers of operations running with ErrorBehaviorHalt stop early.
+// ctx must hold a response context.
+func WithHaltOnError(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	c := getResponseContext(ctx)
+	c.errorsMu.Lock()
+	c.halt = cancel
+	c.errorsMu.Unlock()
+	return ctx, cancel
+}
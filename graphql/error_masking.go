@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ClientSafe is implemented by errors that know whether their message can be shown to clients
+// by MaskingErrorPresenter.
+type ClientSafe interface {
+	ClientSafe() bool
+}
+
+type clientSafeError struct {
+	error
+}
+
+func (e clientSafeError) Unwrap() error {
+	return e.error
+}
+
+func (e clientSafeError) ClientSafe() bool {
+	return true
+}
+
+// SafeError marks err as safe to show to clients, so MaskingErrorPresenter presents its message
+// instead of masking it.
+func SafeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return clientSafeError{err}
+}
+
+// SafeErrorf formats an error that is safe to show to clients.
+func SafeErrorf(format string, args ...any) error {
+	return SafeError(fmt.Errorf(format, args...))
+}
+
+// MaskingErrorPresenter hides the details of unexpected errors from clients. Errors that are not
+// safe to show are replaced with a generic message and a correlation ID, in the correlationId
+// extension, while the original error is logged with the same ID and its path. The code
+// extension set by errcode.Set is preserved.
+//
+// Errors are safe to show when they are:
+//
+//   - errors that implement ClientSafe and return true, or wrap one, as errors created by
+//     SafeError do. Only the message of that error is shown;
+//   - a *gqlerror.Error that does not wrap another error, as created by gqlerror.Errorf and by
+//     gqlgen itself for validation errors;
+//   - errors coercing arguments and input fields, returned by CoercionErrorOnPath.
+//
+// Use Present as the error presenter and Recover as the panic handler:
+//
+//	masking := &graphql.MaskingErrorPresenter{Logger: logger}
+//	srv.SetErrorPresenter(masking.Present)
+//	srv.SetRecoverFunc(masking.Recover)
+type MaskingErrorPresenter struct {
+	// Message replaces the message of masked errors. Defaults to "internal system error".
+	Message string
+
+	// Logger receives the original errors. Defaults to slog.Default().
+	Logger *slog.Logger
+
+	// CorrelationID returns the ID linking a masked error to its log record, for example the ID
+	// of the request found in ctx. Defaults to a random ID.
+	CorrelationID func(ctx context.Context) string
+}
+
+// Present is an ErrorPresenterFunc masking errors that are not safe to show to clients.
+func (p *MaskingErrorPresenter) Present(ctx context.Context, err error) *gqlerror.Error {
+	presented := DefaultErrorPresenter(ctx, err)
+	if presented == nil || p.presentClientSafe(err, presented) {
+		return presented
+	}
+
+	id := p.correlationID(ctx)
+	p.logger().LogAttrs(ctx, slog.LevelError, "graphql error",
+		slog.String("correlation_id", id),
+		slog.String("path", presented.Path.String()),
+		slog.String("error", presented.Message),
+	)
+
+	masked := p.maskedError(id)
+	masked.Path = presented.Path
+	masked.Locations = presented.Locations
+	if code, ok := presented.Extensions["code"]; ok {
+		masked.Extensions["code"] = code
+	}
+	return masked
+}
+
+// Recover is a RecoverFunc logging the panic with its stack trace and returning a masked error.
+func (p *MaskingErrorPresenter) Recover(ctx context.Context, err any) error {
+	id := p.correlationID(ctx)
+	p.logger().LogAttrs(ctx, slog.LevelError, "graphql panic",
+		slog.String("correlation_id", id),
+		slog.String("path", GetPath(ctx).String()),
+		slog.String("error", fmt.Sprint(err)),
+		slog.String("stack", string(debug.Stack())),
+	)
+
+	return p.maskedError(id)
+}
+
+// presentClientSafe reports whether err is safe to show to clients. When it wraps a ClientSafe
+// error, only the message of that error is shown, since the errors wrapping it may add details
+// that are not safe.
+func (p *MaskingErrorPresenter) presentClientSafe(err error, presented *gqlerror.Error) bool {
+	var safe ClientSafe
+	if !errors.As(err, &safe) {
+		return presented.Unwrap() == nil || isCoercionError(err)
+	}
+	if !safe.ClientSafe() {
+		return false
+	}
+	if safeErr, ok := safe.(error); ok {
+		presented.Message = safeErr.Error()
+	}
+	return true
+}
+
+func (p *MaskingErrorPresenter) maskedError(id string) *gqlerror.Error {
+	message := p.Message
+	if message == "" {
+		message = "internal system error"
+	}
+	return &gqlerror.Error{
+		Message:    message,
+		Extensions: map[string]any{"correlationId": id},
+	}
+}
+
+func (p *MaskingErrorPresenter) correlationID(ctx context.Context) string {
+	if p.CorrelationID != nil {
+		return p.CorrelationID(ctx)
+	}
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func (p *MaskingErrorPresenter) logger() *slog.Logger {
+	if p.Logger != nil {
+		return p.Logger
+	}
+	return slog.Default()
+}
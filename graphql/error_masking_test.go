@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestMaskingErrorPresenter(t *testing.T) {
+	var logs bytes.Buffer
+	p := &MaskingErrorPresenter{
+		Logger:        slog.New(slog.NewTextHandler(&logs, nil)),
+		CorrelationID: func(ctx context.Context) string { return "abc123" },
+	}
+
+	field := &ast.Field{Alias: "user", Name: "user", Definition: &ast.FieldDefinition{}}
+	ctx := WithFieldContext(context.Background(), &FieldContext{Field: CollectedField{Field: field}})
+
+	coded := gqlerror.Wrap(errors.New("pq: connection refused"))
+	coded.Extensions = map[string]any{"code": "UNAVAILABLE", "detail": "db-1"}
+
+	tests := []struct {
+		name       string
+		err        error
+		message    string
+		extensions map[string]any
+	}{
+		{
+			name:       "masks plain errors",
+			err:        errors.New("pq: connection refused"),
+			message:    "internal system error",
+			extensions: map[string]any{"correlationId": "abc123"},
+		},
+		{
+			name:       "preserves codes",
+			err:        coded,
+			message:    "internal system error",
+			extensions: map[string]any{"correlationId": "abc123", "code": "UNAVAILABLE"},
+		},
+		{
+			name:    "shows safe errors",
+			err:     SafeErrorf("user %d not found", 1),
+			message: "user 1 not found",
+		},
+		{
+			name:    "shows only the message of wrapped safe errors",
+			err:     fmt.Errorf("loading user 1 from db: %w", SafeErrorf("user %d not found", 1)),
+			message: "user 1 not found",
+		},
+		{
+			name:    "shows graphql errors",
+			err:     gqlerror.Errorf("not allowed"),
+			message: "not allowed",
+		},
+		{
+			name: "shows argument coercion errors",
+			err: CoercionErrorOnPath(WithPathContext(ctx, NewPathWithField("id")),
+				errors.New("id must be an Int")),
+			message: "id must be an Int",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logs.Reset()
+			err := p.Present(ctx, ErrorOnPath(ctx, tc.err))
+			require.Equal(t, tc.message, err.Message)
+			require.Equal(t, tc.extensions, err.Extensions)
+			if tc.extensions != nil {
+				require.Equal(t, "user", err.Path.String())
+				require.Contains(t, logs.String(), "correlation_id=abc123 path=user")
+				require.Contains(t, logs.String(), `error="pq: connection refused"`)
+			} else {
+				require.Empty(t, logs.String())
+			}
+		})
+	}
+
+	t.Run("masks other errors on argument paths", func(t *testing.T) {
+		logs.Reset()
+		argCtx := WithPathContext(ctx, NewPathWithField("id"))
+		err := p.Present(argCtx, ErrorOnPath(argCtx, errors.New("pq: connection refused")))
+		require.Equal(t, "internal system error", err.Message)
+		require.Equal(t, "user.id", err.Path.String())
+	})
+
+	t.Run("masks panics", func(t *testing.T) {
+		logs.Reset()
+		err := p.Recover(ctx, "runtime error: index out of range")
+
+		presented := p.Present(ctx, ErrorOnPath(ctx, err))
+		require.Equal(t, "internal system error", presented.Message)
+		require.Equal(t, map[string]any{"correlationId": "abc123"}, presented.Extensions)
+		require.Contains(t, logs.String(), `msg="graphql panic"`)
+		require.Contains(t, logs.String(), `error="runtime error: index out of range"`)
+		require.Contains(t, logs.String(), "stack=")
+	})
+}
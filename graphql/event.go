@@ -21,9 +21,15 @@ import (
 // When Event.Context is nil, the engine falls back to the input context
 // driving the subscription iteration; resolvers should set Context
 // explicitly for every event they publish.
+//
+// ID optionally identifies the event as a cursor from which the subscription
+// can be resumed. Transports supporting it send it along with the event's
+// response, as transport.SSE does in the id field of its events, and give the
+// id last received by the client back to the resolver when it resubscribes.
 type Event[T any] struct {
 	Context context.Context
 	Value   T
+	ID      string
 }
 
 // StreamWithoutEventContext adapts a plain subscription stream handler to the
@@ -55,10 +61,23 @@ func SubscriptionEventResponseHandler(
 			return ctx, nil
 		}
 		data.MarshalGQL(&buf)
-		return eventCtx, &Response{Data: buf.Bytes()}
+		return eventCtx, &Response{Data: buf.Bytes(), EventID: eventID(eventCtx)}
 	}
 }
 
+const eventIDCtx key = "event_id_context"
+
+// withEventID returns a copy of the context of an event carrying its id, for the response of the
+// event.
+func withEventID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, eventIDCtx, id)
+}
+
+func eventID(ctx context.Context) string {
+	id, _ := ctx.Value(eventIDCtx).(string)
+	return id
+}
+
 // SubscriptionResponseHandler builds a plain [ResponseHandler] from the same
 // subscription stream handler, discarding each event's context. It backs the
 // default Exec path, where per-event context is not surfaced;
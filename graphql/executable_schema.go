@@ -68,7 +68,7 @@ func collectFields(
 	for _, sel := range selSet {
 		switch sel := sel.(type) {
 		case *ast.Field:
-			if !shouldIncludeNode(sel.Directives, reqCtx.Variables) {
+			if !ShouldIncludeNode(sel.Directives, reqCtx.Variables) {
 				continue
 			}
 			f := getOrCreateAndAppendField(
@@ -84,10 +84,13 @@ func collectFields(
 			if !parentIsDeferredFragment {
 				f.IsNonDeferrable = true
 			}
+			if f.Stream == nil {
+				f.Stream = streamable(sel.Directives, reqCtx.Variables)
+			}
 			f.Selections = append(f.Selections, sel.SelectionSet...)
 
 		case *ast.InlineFragment:
-			if !shouldIncludeNode(sel.Directives, reqCtx.Variables) {
+			if !ShouldIncludeNode(sel.Directives, reqCtx.Variables) {
 				continue
 			}
 			if !doesFragmentConditionMatch(sel.TypeCondition, satisfies) {
@@ -116,6 +119,9 @@ func collectFields(
 					f.Deferrables = slices.Grow(f.Deferrables, len(childField.Deferrables)+1)
 					f.Deferrables = append(f.Deferrables, childField.Deferrables...)
 					f.IsNonDeferrable = f.IsNonDeferrable || childField.IsNonDeferrable
+					if f.Stream == nil {
+						f.Stream = childField.Stream
+					}
 				}
 
 				if shouldDefer {
@@ -130,7 +136,7 @@ func collectFields(
 			if _, seen := visited[fragmentName]; seen {
 				continue
 			}
-			if !shouldIncludeNode(sel.Directives, reqCtx.Variables) {
+			if !ShouldIncludeNode(sel.Directives, reqCtx.Variables) {
 				continue
 			}
 			visited[fragmentName] = true
@@ -167,6 +173,9 @@ func collectFields(
 					f.Deferrables = slices.Grow(f.Deferrables, len(childField.Deferrables)+1)
 					f.Deferrables = append(f.Deferrables, childField.Deferrables...)
 					f.IsNonDeferrable = f.IsNonDeferrable || childField.IsNonDeferrable
+					if f.Stream == nil {
+						f.Stream = childField.Stream
+					}
 				}
 
 				if shouldDefer {
@@ -208,6 +217,9 @@ type CollectedField struct {
 	//	}
 	IsNonDeferrable bool
 	Deferrables     []*Deferrable
+
+	// Stream is the @stream directive of the field, if it is a list whose items are streamed.
+	Stream *Streamable
 }
 
 // IsDeferred reports whether this field's resolution should be deferred
@@ -273,7 +285,10 @@ func getOrCreateAndAppendField(
 	return &(*c)[len(*c)-1]
 }
 
-func shouldIncludeNode(directives ast.DirectiveList, variables map[string]any) bool {
+// ShouldIncludeNode reports whether a selection with the given directives is part of the
+// operation, by evaluating its @skip and @include directives against variables. The directives
+// must have passed validation.
+func ShouldIncludeNode(directives ast.DirectiveList, variables map[string]any) bool {
 	if len(directives) == 0 {
 		return true
 	}
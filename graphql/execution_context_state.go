@@ -1,8 +1,10 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -95,16 +97,106 @@ func (ec *ExecutionContextState[R, D, C]) ProcessDeferredGroup(dg DeferredGroup)
 	}()
 }
 
+// ProcessStream delivers the items of sg to ec.DeferredResults, one DeferredResult each, as they
+// resolve, then an empty DeferredResult ending the stream. Each holds the errors of its item.
+func (ec *ExecutionContextState[R, D, C]) ProcessStream(sg StreamGroup) {
+	atomic.AddInt32(&ec.Deferred, 1)
+	// the stream holds one pending result until it ends, so that the responses have a next one
+	atomic.AddInt32(&ec.PendingDeferred, 1)
+	ctx := WithFreshResponseContext(sg.Context)
+
+	go func() {
+		defer sg.Stop()
+
+		errorsEmitted := 0
+		for index := sg.Start; ; index++ {
+			items, ok := sg.Next(ctx, index)
+			if !ok {
+				break
+			}
+
+			allErrors := GetErrors(ctx)
+			ds := DeferredResult{
+				Path:   append(slices.Clip(sg.Path), ast.PathIndex(index)),
+				Label:  sg.Label,
+				Items:  items,
+				Errors: allErrors[errorsEmitted:],
+			}
+			errorsEmitted = len(allErrors)
+
+			atomic.AddInt32(&ec.PendingDeferred, 1)
+			select {
+			case ec.DeferredResults <- ds:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case ec.DeferredResults <- DeferredResult{}:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// NextDeferredResponse returns the response of the next deferred fragment or streamed item, or
+// nil once they are all delivered.
+func (ec *ExecutionContextState[R, D, C]) NextDeferredResponse() *Response {
+	var result DeferredResult
+	for {
+		if atomic.LoadInt32(&ec.PendingDeferred) <= 0 {
+			return nil
+		}
+		result = <-ec.DeferredResults
+		remaining := atomic.AddInt32(&ec.PendingDeferred, -1)
+		// the end of a stream only needs a response when it is the last result
+		if result.Items != nil || result.Result != nil || remaining == 0 {
+			break
+		}
+	}
+
+	response := &Response{
+		Path:   result.Path,
+		Label:  result.Label,
+		Errors: result.Errors,
+	}
+	var buf bytes.Buffer
+	switch {
+	case result.Items != nil:
+		result.Items.MarshalGQL(&buf)
+		response.Items = buf.Bytes()
+	case result.Result != nil:
+		result.Result.MarshalGQL(&buf)
+		response.Data = buf.Bytes()
+	}
+	hasNext := atomic.LoadInt32(&ec.PendingDeferred) > 0
+	response.HasNext = &hasNext
+	return response
+}
+
 func (ec *ExecutionContextState[R, D, C]) IntrospectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapSchema(ec.Schema()), nil
+	schema := introspection.WrapSchema(ec.visibleSchema())
+	if ec.OperationContext != nil {
+		schema = schema.WithDefaultErrorBehavior(string(ec.DefaultOnError))
+	}
+	return schema, nil
 }
 
 func (ec *ExecutionContextState[R, D, C]) IntrospectType(name string) (*introspection.Type, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+	schema := ec.visibleSchema()
+	return introspection.WrapTypeFromDef(schema, schema.Types[name]), nil
+}
+
+// visibleSchema is the schema introspection reports to the operation.
+func (ec *ExecutionContextState[R, D, C]) visibleSchema() *ast.Schema {
+	if ec.OperationContext != nil && ec.VisibleSchema != nil {
+		return ec.VisibleSchema
+	}
+	return ec.Schema()
 }
@@ -133,6 +133,31 @@ func TestExecutionContextState_IntrospectType(t *testing.T) {
 	assert.Nil(t, missing)
 }
 
+func TestExecutionContextState_IntrospectVisibleSchema(t *testing.T) {
+	ec := newTestExecutionContextState(
+		&OperationContext{VisibleSchema: makeSchemaWithType("Bar")},
+		nil,
+		makeSchemaWithType("Foo"),
+		nil,
+	)
+
+	hidden, err := ec.IntrospectType("Foo")
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+
+	typ, err := ec.IntrospectType("Bar")
+	require.NoError(t, err)
+	require.NotNil(t, typ)
+
+	schema, err := ec.IntrospectSchema()
+	require.NoError(t, err)
+	var names []string
+	for _, typ := range schema.Types() {
+		names = append(names, *typ.Name())
+	}
+	assert.Equal(t, []string{"Bar", "Query"}, names)
+}
+
 func TestExecutionContextState_ProcessDeferredGroup_IncrementsPendingAndPropagates(t *testing.T) {
 	deferredResults := make(chan DeferredResult, 1)
 	ec := newTestExecutionContextState(
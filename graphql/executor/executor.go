@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"sync"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -24,10 +25,15 @@ type Executor struct {
 	errorPresenter graphql.ErrorPresenterFunc
 	recoverFunc    graphql.RecoverFunc
 	queryCache     graphql.Cache[*ast.QueryDocument]
+	errorRegistry  *errcode.Registry
 
-	parserTokenLimit  int
-	disableSuggestion bool
-	defaultRulesFn    func() *rules.Rules
+	parserTokenLimit     int
+	disableSuggestion    bool
+	defaultRulesFn       func() *rules.Rules
+	defaultErrorBehavior graphql.ErrorBehavior
+
+	sensitivityOnce sync.Once
+	sensitivity     *graphql.Sensitivity
 }
 
 var _ graphql.GraphExecutor = &Executor{}
@@ -36,12 +42,14 @@ var _ graphql.GraphExecutor = &Executor{}
 // recovery callbacks, and no query cache or extensions.
 func New(es graphql.ExecutableSchema) *Executor {
 	e := &Executor{
-		es:               es,
-		errorPresenter:   graphql.DefaultErrorPresenter,
-		recoverFunc:      graphql.DefaultRecover,
-		queryCache:       graphql.NoCache[*ast.QueryDocument]{},
-		ext:              processExtensions(nil),
-		parserTokenLimit: parserTokenNoLimit,
+		es:                   es,
+		errorPresenter:       graphql.DefaultErrorPresenter,
+		recoverFunc:          graphql.DefaultRecover,
+		queryCache:           graphql.NoCache[*ast.QueryDocument]{},
+		errorRegistry:        errcode.NewRegistry(),
+		ext:                  processExtensions(nil),
+		parserTokenLimit:     parserTokenNoLimit,
+		defaultErrorBehavior: graphql.ErrorBehaviorPropagate,
 	}
 	return e
 }
@@ -77,9 +85,26 @@ func (e *Executor) CreateOperationContext(
 	opCtx.OperationName = params.OperationName
 	opCtx.Extensions = params.Extensions
 	opCtx.Headers = params.Headers
+	opCtx.Sensitivity = e.getSensitivity()
+	opCtx.DefaultOnError = e.defaultErrorBehavior
+	opCtx.OnError = e.defaultErrorBehavior
+	if onError, ok := params.Extensions[graphql.OnErrorExtension]; ok {
+		behavior, err := graphql.ParseErrorBehavior(onError)
+		if err != nil {
+			gqlErr := gqlerror.Wrap(err)
+			errcode.Set(gqlErr, errcode.ValidationFailed)
+			return opCtx, gqlerror.List{gqlErr}
+		}
+		opCtx.OnError = behavior
+	}
+
+	schema, cacheKey := e.es.Schema(), params.Query
+	if opCtx.VisibleSchema != nil {
+		schema, cacheKey = opCtx.VisibleSchema, opCtx.VisibleSchemaKey+"\x00"+params.Query
+	}
 
 	var listErr gqlerror.List
-	opCtx.Doc, listErr = e.parseQuery(ctx, &opCtx.Stats, params.Query)
+	opCtx.Doc, listErr = e.parseQuery(ctx, &opCtx.Stats, schema, cacheKey, params.Query)
 	if len(listErr) != 0 {
 		return opCtx, listErr
 	}
@@ -93,7 +118,7 @@ func (e *Executor) CreateOperationContext(
 
 	var err error
 	opCtx.Variables, err = validator.VariableValues(
-		e.es.Schema(),
+		schema,
 		opCtx.Operation,
 		params.Variables,
 	)
@@ -153,6 +178,7 @@ func (e *Executor) DispatchOperation(
 				return nil
 			}
 			ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+			ctx, halt := withErrorBehavior(ctx, opCtx)
 			resp := e.ext.responseMiddleware(ctx, func(ctx context.Context) *graphql.Response {
 				resp := responses(ctx)
 				if resp == nil {
@@ -160,8 +186,12 @@ func (e *Executor) DispatchOperation(
 				}
 				resp.Errors = append(resp.Errors, graphql.GetErrors(ctx)...)
 				resp.Extensions = graphql.GetExtensions(ctx)
+				applyErrorBehavior(opCtx, resp)
 				return resp
 			})
+			if resp == nil || resp.HasNext == nil || !*resp.HasNext {
+				halt()
+			}
 			if resp == nil {
 				return nil
 			}
@@ -192,6 +222,8 @@ func (e *Executor) dispatchWithEventContext(
 			return nil
 		}
 		ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+		ctx, halt := withErrorBehavior(ctx, graphql.GetOperationContext(tmpResponseContext))
+		defer halt()
 		eventCtx, raw := responses(ctx)
 		if raw == nil {
 			return nil
@@ -199,11 +231,39 @@ func (e *Executor) dispatchWithEventContext(
 		return e.ext.responseMiddleware(eventCtx, func(ctx context.Context) *graphql.Response {
 			raw.Errors = append(raw.Errors, graphql.GetErrors(ctx)...)
 			raw.Extensions = graphql.GetExtensions(ctx)
+			applyErrorBehavior(graphql.GetOperationContext(ctx), raw)
 			return raw
 		})
 	}
 }
 
+// withErrorBehavior returns a copy of ctx canceled at the first error of operations running with
+// graphql.ErrorBehaviorHalt, and the function releasing it.
+func withErrorBehavior(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) (context.Context, context.CancelFunc) {
+	if opCtx.OnError != graphql.ErrorBehaviorHalt {
+		return ctx, func() {}
+	}
+	return graphql.WithHaltOnError(ctx)
+}
+
+// applyErrorBehavior aborts responses with errors of operations running with
+// graphql.ErrorBehaviorHalt, keeping only their first error. Non-null propagation is handled by
+// the generated code.
+func applyErrorBehavior(opCtx *graphql.OperationContext, resp *graphql.Response) {
+	if opCtx.OnError != graphql.ErrorBehaviorHalt || len(resp.Errors) == 0 {
+		return
+	}
+	resp.Data = nil
+	resp.Errors = resp.Errors[:1]
+	if resp.HasNext != nil {
+		hasNext := false
+		resp.HasNext = &hasNext
+	}
+}
+
 func (e *Executor) DispatchError(ctx context.Context, list gqlerror.List) *graphql.Response {
 	ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
 	for _, gErr := range list {
@@ -225,10 +285,24 @@ func (e *Executor) PresentRecoveredError(ctx context.Context, err any) error {
 	return e.errorPresenter(ctx, e.recoverFunc(ctx, err))
 }
 
+// getSensitivity returns the Sensitivity of the schema, computed on first use as mocked schemas
+// may not be ready when the executor is created.
+func (e *Executor) getSensitivity() *graphql.Sensitivity {
+	e.sensitivityOnce.Do(func() {
+		e.sensitivity = graphql.NewSensitivity(e.es.Schema(), graphql.SensitiveDirective)
+	})
+	return e.sensitivity
+}
+
 func (e *Executor) SetQueryCache(cache graphql.Cache[*ast.QueryDocument]) {
 	e.queryCache = cache
 }
 
+// ErrorRegistry returns the registry transports use to report errors by their code.
+func (e *Executor) ErrorRegistry() *errcode.Registry {
+	return e.errorRegistry
+}
+
 func (e *Executor) SetErrorPresenter(f graphql.ErrorPresenterFunc) {
 	e.errorPresenter = f
 }
@@ -245,7 +319,14 @@ func (e *Executor) SetDisableSuggestion(value bool) {
 	e.disableSuggestion = value
 }
 
-// parseQuery decodes the incoming query and validates it, pulling from cache if present.
+// SetDefaultErrorBehavior sets the behavior on field errors of requests not selecting one with
+// the onError request extension. It defaults to graphql.ErrorBehaviorPropagate.
+func (e *Executor) SetDefaultErrorBehavior(behavior graphql.ErrorBehavior) {
+	e.defaultErrorBehavior = behavior
+}
+
+// parseQuery decodes the incoming query and validates it against schema, pulling from cache if
+// present under cacheKey.
 //
 // NOTE: This should NOT look at variables, they will change per request. It should only parse and
 // validate
@@ -253,11 +334,12 @@ func (e *Executor) SetDisableSuggestion(value bool) {
 func (e *Executor) parseQuery(
 	ctx context.Context,
 	stats *graphql.Stats,
-	query string,
+	schema *ast.Schema,
+	cacheKey, query string,
 ) (*ast.QueryDocument, gqlerror.List) {
 	stats.Parsing.Start = graphql.Now()
 
-	if doc, ok := e.queryCache.Get(ctx, query); ok {
+	if doc, ok := e.queryCache.Get(ctx, cacheKey); ok {
 		now := graphql.Now()
 
 		stats.Parsing.End = now
@@ -312,7 +394,7 @@ func (e *Executor) parseQuery(
 		currentRules.AddRule(scalarLeafsRule.Name, scalarLeafsRule.RuleFunc)
 	}
 
-	listErr := validator.ValidateWithRules(e.es.Schema(), doc, currentRules)
+	listErr := validator.ValidateWithRules(schema, doc, currentRules)
 	if len(listErr) != 0 {
 		for _, e := range listErr {
 			errcode.Set(e, errcode.ValidationFailed)
@@ -320,7 +402,7 @@ func (e *Executor) parseQuery(
 		return nil, listErr
 	}
 
-	e.queryCache.Add(ctx, query, doc)
+	e.queryCache.Add(ctx, cacheKey, doc)
 
 	return doc, nil
 }
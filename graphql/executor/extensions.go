@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
 )
 
 // Use adds the given extension to this Executor.
@@ -14,6 +15,11 @@ func (e *Executor) Use(extension graphql.HandlerExtension) {
 	if err := extension.Validate(e.es); err != nil {
 		panic(err)
 	}
+	if provider, ok := extension.(errcode.ErrorTypeProvider); ok {
+		for code, errorType := range provider.ErrorTypes() {
+			e.errorRegistry.Register(code, errorType)
+		}
+	}
 
 	switch extension.(type) {
 	case graphql.OperationParameterMutator,
@@ -55,15 +55,15 @@ func (a Tracer) InterceptResponse(
 	opCtx := graphql.GetOperationContext(ctx)
 
 	_, _ = fmt.Fprintln(a.out, "GraphQL Request {")
-	for line := range strings.SplitSeq(opCtx.RawQuery, "\n") {
+	for line := range strings.SplitSeq(opCtx.Sensitivity.RedactQuery(opCtx), "\n") {
 		_, _ = fmt.Fprintln(a.out, " ", aurora.Cyan(line))
 	}
-	for name, value := range opCtx.Variables {
+	for name, value := range opCtx.Sensitivity.RedactVariables(opCtx) {
 		_, _ = fmt.Fprintf(a.out, "  var %s = %s\n", name, aurora.Yellow(stringify(value)))
 	}
 	resp := next(ctx)
 
-	_, _ = fmt.Fprintln(a.out, "  resp:", aurora.Green(stringify(resp)))
+	_, _ = fmt.Fprintln(a.out, "  resp:", aurora.Green(stringify(redactResponse(opCtx, resp))))
 	if resp != nil {
 		for _, err := range resp.Errors {
 			_, _ = fmt.Fprintln(
@@ -79,3 +79,13 @@ func (a Tracer) InterceptResponse(
 	_, _ = fmt.Fprintln(a.out)
 	return resp
 }
+
+// redactResponse returns a copy of resp with the values of @sensitive fields redacted.
+func redactResponse(opCtx *graphql.OperationContext, resp *graphql.Response) *graphql.Response {
+	if resp == nil || opCtx.Sensitivity == nil {
+		return resp
+	}
+	redacted := *resp
+	redacted.Data = opCtx.Sensitivity.RedactResponse(opCtx, resp.Data)
+	return &redacted
+}
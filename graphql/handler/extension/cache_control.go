@@ -0,0 +1,275 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const cacheControlExtension = "CacheControl"
+
+type cacheControlCtxKey string
+
+const (
+	cachePolicyCtx cacheControlCtxKey = "cache_policy"
+	cacheHintCtx   cacheControlCtxKey = "cache_hint"
+)
+
+// CacheScope is the audience a response can be cached for.
+type CacheScope string
+
+const (
+	// CacheScopePublic responses can be stored by shared caches such as CDNs.
+	CacheScopePublic CacheScope = "PUBLIC"
+	// CacheScopePrivate responses depend on the user and may only be stored by their client.
+	CacheScopePrivate CacheScope = "PRIVATE"
+)
+
+// CacheControl computes a cache policy for query responses from the @cacheControl hints in the
+// schema, and sets the Cache-Control header of the HTTP response accordingly. The schema must
+// declare the directive:
+//
+//	enum CacheControlScope {
+//	  PUBLIC
+//	  PRIVATE
+//	}
+//
+//	directive @cacheControl(
+//	  maxAge: Int
+//	  scope: CacheControlScope
+//	  inheritMaxAge: Boolean
+//	) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+//
+// The policy of a response has the lowest maxAge of any field resolved for it, and is private if
+// any of those fields is. Hints on a field take precedence over hints on the type it returns.
+// Root fields, and fields returning objects, interfaces or unions, without a maxAge get
+// DefaultMaxAge unless they set inheritMaxAge, while other fields inherit the policy of their
+// parent. Resolvers can replace the hint of their field with SetCacheHint.
+//
+// Responses are only cached if they have a positive maxAge and no errors. Mutations,
+// subscriptions and incremental responses are never cached.
+type CacheControl struct {
+	// DefaultMaxAge is the maxAge of root fields and fields returning composite types that have
+	// no hint. The default of 0 makes responses uncacheable unless every such field has a hint.
+	DefaultMaxAge int
+
+	schema *ast.Schema
+	types  map[string]cacheHint
+	fields map[string]cacheHint
+}
+
+// CachePolicy is the cache policy computed for a response.
+type CachePolicy struct {
+	// MaxAge is the number of seconds the response can be cached for
+	MaxAge int
+
+	// Scope is the audience the response can be cached for
+	Scope CacheScope
+}
+
+type cacheHint struct {
+	maxAge        *int
+	scope         CacheScope
+	inheritMaxAge bool
+}
+
+type cachePolicy struct {
+	mu     sync.Mutex
+	policy CachePolicy
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = &CacheControl{}
+
+func (c CacheControl) ExtensionName() string {
+	return cacheControlExtension
+}
+
+// Validate reads the @cacheControl hints from the schema. They are read once, when the
+// extension is added to a server, rather than generated, so that the extension works with any
+// ExecutableSchema and hints can be added to a schema without regenerating code.
+func (c *CacheControl) Validate(schema graphql.ExecutableSchema) error {
+	c.schema = schema.Schema()
+	c.types = map[string]cacheHint{}
+	c.fields = map[string]cacheHint{}
+
+	for _, def := range c.schema.Types {
+		hint, ok, err := parseCacheHint(def.Directives)
+		if err != nil {
+			return fmt.Errorf("invalid cache hint on %s: %w", def.Name, err)
+		}
+		if ok {
+			c.types[def.Name] = hint
+		}
+
+		for _, field := range def.Fields {
+			hint, ok, err := parseCacheHint(field.Directives)
+			if err != nil {
+				return fmt.Errorf("invalid cache hint on %s.%s: %w", def.Name, field.Name, err)
+			}
+			if ok {
+				c.fields[def.Name+"."+field.Name] = hint
+			}
+		}
+	}
+	return nil
+}
+
+// parseCacheHint returns the hint of the @cacheControl directive in directives, and false if
+// there is none.
+func parseCacheHint(directives ast.DirectiveList) (cacheHint, bool, error) {
+	var hint cacheHint
+	d := directives.ForName("cacheControl")
+	if d == nil {
+		return hint, false, nil
+	}
+
+	if arg := d.Arguments.ForName("maxAge"); arg != nil && arg.Value.Kind == ast.IntValue {
+		maxAge, err := strconv.Atoi(arg.Value.Raw)
+		if err != nil {
+			return hint, false, fmt.Errorf("maxAge: %w", err)
+		}
+		hint.maxAge = &maxAge
+	}
+	if arg := d.Arguments.ForName("scope"); arg != nil && arg.Value.Kind == ast.EnumValue {
+		hint.scope = CacheScope(arg.Value.Raw)
+	}
+	if arg := d.Arguments.ForName("inheritMaxAge"); arg != nil {
+		hint.inheritMaxAge = arg.Value.Raw == "true"
+	}
+	return hint, true, nil
+}
+
+func (c CacheControl) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query {
+		return next(ctx)
+	}
+	if _, ok := ctx.Value(cachePolicyCtx).(*cachePolicy); ok {
+		// only the first response of an operation computes the policy
+		return next(ctx)
+	}
+
+	p := &cachePolicy{policy: CachePolicy{MaxAge: -1, Scope: CacheScopePublic}}
+	resp := next(context.WithValue(ctx, cachePolicyCtx, p))
+
+	p.mu.Lock()
+	policy := p.policy
+	p.mu.Unlock()
+	if policy.MaxAge < 0 {
+		// nothing restricted the policy, e.g. a query selecting only __typename
+		policy.MaxAge = c.DefaultMaxAge
+	}
+	if resp == nil || len(resp.Errors) > 0 || resp.HasNext != nil {
+		policy.MaxAge = 0
+	}
+	opCtx.Stats.SetExtension(cacheControlExtension, &policy)
+
+	if header := graphql.GetResponseHeader(ctx); header != nil && policy.MaxAge > 0 {
+		header.Set("Cache-Control", fmt.Sprintf("max-age=%d, %s",
+			policy.MaxAge, strings.ToLower(string(policy.Scope))))
+	}
+	return resp
+}
+
+func (c CacheControl) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	p, ok := ctx.Value(cachePolicyCtx).(*cachePolicy)
+	if !ok {
+		return next(ctx)
+	}
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil ||
+		strings.HasPrefix(fc.Field.Name, "__") {
+		return next(ctx)
+	}
+
+	hint := c.fieldHint(fc)
+	dynamic := &cacheHint{}
+	res, err := next(context.WithValue(ctx, cacheHintCtx, dynamic))
+	if dynamic.maxAge != nil {
+		hint.maxAge = dynamic.maxAge
+	}
+	if dynamic.scope == CacheScopePrivate {
+		hint.scope = CacheScopePrivate
+	}
+
+	p.mu.Lock()
+	if hint.maxAge != nil && (p.policy.MaxAge < 0 || *hint.maxAge < p.policy.MaxAge) {
+		p.policy.MaxAge = *hint.maxAge
+	}
+	if hint.scope == CacheScopePrivate {
+		p.policy.Scope = CacheScopePrivate
+	}
+	p.mu.Unlock()
+
+	return res, err
+}
+
+// fieldHint merges the hints on a field and the type it returns. The maxAge is left unset for
+// fields that inherit the policy of their parent.
+func (c CacheControl) fieldHint(fc *graphql.FieldContext) cacheHint {
+	fieldHint, hasFieldHint := c.fields[fc.Object+"."+fc.Field.Name]
+
+	returnType := c.schema.Types[fc.Field.Definition.Type.Name()]
+	composite := returnType != nil && returnType.IsCompositeType()
+
+	var hint cacheHint
+	if composite {
+		hint = c.types[returnType.Name]
+	}
+	if hasFieldHint {
+		if fieldHint.maxAge != nil || fieldHint.inheritMaxAge {
+			hint.maxAge = fieldHint.maxAge
+			hint.inheritMaxAge = fieldHint.inheritMaxAge
+		}
+		if fieldHint.scope != "" {
+			hint.scope = fieldHint.scope
+		}
+	}
+
+	if hint.maxAge == nil && !hint.inheritMaxAge && (composite || fc.Parent == nil) {
+		defaultMaxAge := c.DefaultMaxAge
+		hint.maxAge = &defaultMaxAge
+	}
+	return hint
+}
+
+// SetCacheHint replaces the maxAge of the @cacheControl hint on the field being resolved, and
+// marks it as private if scope is CacheScopePrivate. Call it from a resolver to make the cache
+// policy of a response depend on the data it returns. It does nothing when the CacheControl
+// extension is not in use.
+func SetCacheHint(ctx context.Context, maxAge int, scope CacheScope) {
+	hint, ok := ctx.Value(cacheHintCtx).(*cacheHint)
+	if !ok {
+		return
+	}
+	hint.maxAge = &maxAge
+	hint.scope = scope
+}
+
+// GetCachePolicy returns the cache policy computed for the response to the current operation,
+// or nil if the CacheControl extension did not compute one.
+func GetCachePolicy(ctx context.Context) *CachePolicy {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(cacheControlExtension).(*CachePolicy)
+	return s
+}
@@ -0,0 +1,189 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestCacheControl(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		enum CacheControlScope { PUBLIC PRIVATE }
+		directive @cacheControl(
+			maxAge: Int
+			scope: CacheControlScope
+			inheritMaxAge: Boolean
+		) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+
+		type Query {
+			posts: [Post!]! @cacheControl(maxAge: 60)
+			me: User @cacheControl(maxAge: 30, scope: PRIVATE)
+			version: String
+			post: Post
+			dynamic: Post
+			broken: String @cacheControl(maxAge: 60)
+		}
+		type Post @cacheControl(maxAge: 120) {
+			title: String
+			author: User @cacheControl(inheritMaxAge: true)
+		}
+		type User {
+			name: String
+		}
+	`})
+
+	// resolve walks the selections like generated code would, running every field through the
+	// resolver middleware.
+	var resolve func(ctx context.Context, object string, selections ast.SelectionSet)
+	resolve = func(ctx context.Context, object string, selections ast.SelectionSet) {
+		for _, sel := range selections {
+			field := sel.(*ast.Field)
+			fctx := graphql.WithFieldContext(ctx, &graphql.FieldContext{
+				Object: object,
+				Field:  graphql.CollectedField{Field: field},
+			})
+			_, _ = graphql.GetOperationContext(ctx).ResolverMiddleware(fctx,
+				func(ctx context.Context) (any, error) {
+					switch field.Name {
+					case "dynamic":
+						extension.SetCacheHint(ctx, 5, extension.CacheScopePrivate)
+					case "broken":
+						graphql.AddErrorf(ctx, "broken")
+					}
+					resolve(ctx, field.Definition.Type.Name(), field.SelectionSet)
+					return nil, nil
+				})
+		}
+	}
+
+	es := &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			ran := false
+			return func(ctx context.Context) *graphql.Response {
+				if ran {
+					return nil
+				}
+				ran = true
+				opCtx := graphql.GetOperationContext(ctx)
+				resolve(ctx, "Query", opCtx.Operation.SelectionSet)
+				return &graphql.Response{
+					Data:   []byte(`{}`),
+					Errors: graphql.GetErrors(ctx),
+				}
+			}
+		},
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		defaultMaxAge int
+		header        string
+		policy        extension.CachePolicy
+	}{
+		{
+			name:   "uses field hints",
+			query:  `{ posts { title } }`,
+			header: "max-age=60, public",
+			policy: extension.CachePolicy{MaxAge: 60, Scope: extension.CacheScopePublic},
+		},
+		{
+			name:   "uses type hints",
+			query:  `{ post { title } }`,
+			header: "max-age=120, public",
+			policy: extension.CachePolicy{MaxAge: 120, Scope: extension.CacheScopePublic},
+		},
+		{
+			name:   "inherits maxAge",
+			query:  `{ posts { author { name } } }`,
+			header: "max-age=60, public",
+			policy: extension.CachePolicy{MaxAge: 60, Scope: extension.CacheScopePublic},
+		},
+		{
+			name:   "takes the most restrictive hints",
+			query:  `{ posts { title } me { name } }`,
+			header: "max-age=30, private",
+			policy: extension.CachePolicy{MaxAge: 30, Scope: extension.CacheScopePrivate},
+		},
+		{
+			name:   "root fields without hints are not cached",
+			query:  `{ posts { title } version }`,
+			policy: extension.CachePolicy{Scope: extension.CacheScopePublic},
+		},
+		{
+			name:          "root fields without hints use the default maxAge",
+			query:         `{ posts { title } version }`,
+			defaultMaxAge: 10,
+			header:        "max-age=10, public",
+			policy:        extension.CachePolicy{MaxAge: 10, Scope: extension.CacheScopePublic},
+		},
+		{
+			name:   "resolvers set dynamic hints",
+			query:  `{ dynamic { title } }`,
+			header: "max-age=5, private",
+			policy: extension.CachePolicy{MaxAge: 5, Scope: extension.CacheScopePrivate},
+		},
+		{
+			name:   "responses with errors are not cached",
+			query:  `{ broken }`,
+			policy: extension.CachePolicy{Scope: extension.CacheScopePublic},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var policy *extension.CachePolicy
+			h := handler.New(es)
+			h.AddTransport(transport.GET{})
+			h.AddTransport(transport.POST{})
+			h.AroundResponses(
+				func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+					resp := next(ctx)
+					policy = extension.GetCachePolicy(ctx)
+					return resp
+				})
+			h.Use(&extension.CacheControl{DefaultMaxAge: tc.defaultMaxAge})
+
+			resp := doRequest(h, http.MethodGet, "/graphql?query="+url.QueryEscape(tc.query), "")
+			require.Equal(t, tc.header, resp.Header().Get("Cache-Control"), resp.Body.String())
+			require.Equal(t, &tc.policy, policy)
+
+			body := `{"query":` + strconv.Quote(tc.query) + `}`
+			resp = doRequest(h, http.MethodPost, "/graphql", body)
+			require.Equal(t, tc.header, resp.Header().Get("Cache-Control"), resp.Body.String())
+		})
+	}
+
+	t.Run("mutations are not cached", func(t *testing.T) {
+		h := handler.New(&graphql.ExecutableSchemaMock{
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+			},
+			SchemaFunc: func() *ast.Schema {
+				return gqlparser.MustLoadSchema(&ast.Source{Input: `
+					type Query { name: String }
+					type Mutation { name: String }
+				`})
+			},
+		})
+		h.AddTransport(transport.POST{})
+		h.Use(&extension.CacheControl{DefaultMaxAge: 10})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"mutation { name }"}`)
+		require.Empty(t, resp.Header().Get("Cache-Control"))
+	})
+}
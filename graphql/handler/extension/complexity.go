@@ -15,7 +15,9 @@ const errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
 
 // ComplexityLimit allows you to define a limit on query complexity
 //
-// If a query is submitted that exceeds the limit, a 422 status code will be returned.
+// If a query is submitted that exceeds the limit, a 422 status code will be returned. Operations
+// sharing a budget set with graphql.WithComplexityBudget, such as the operations of a batch, are
+// also rejected when they exceed what remains of it.
 type ComplexityLimit struct {
 	Func func(ctx context.Context, opCtx *graphql.OperationContext) int
 
@@ -84,6 +86,16 @@ func (c ComplexityLimit) MutateOperationContext(
 		return err
 	}
 
+	if remaining, ok := graphql.SpendComplexityBudget(ctx, complexityCalcs); !ok {
+		err := gqlerror.Errorf(
+			"operation has complexity %d, which exceeds the remaining complexity budget of %d",
+			complexityCalcs,
+			remaining,
+		)
+		errcode.Set(err, errComplexityLimit)
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,123 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// FieldCache memoizes the results of fields annotated with @cached across requests, for
+// resolvers like exchange rates or feature configuration that return the same value for every
+// caller. Concurrent requests missing the same entry share a single call to the resolver; if
+// that call fails because its own request was cancelled, the others call the resolver again.
+//
+// Results are stored as they are returned by resolvers and shared between requests, so they must
+// not be modified afterwards. Results are only stored when the resolver returns no error.
+type FieldCache struct {
+	// Cache stores the results. graphql.Cache has no expiry, so entries are checked for staleness
+	// when they are read; use a cache that evicts entries, such as lru.LRU, to bound its size.
+	Cache graphql.Cache[*FieldCacheEntry]
+
+	mu    sync.Mutex
+	calls map[string]*fieldCacheCall
+}
+
+// FieldCacheEntry is a result stored by FieldCache.
+type FieldCacheEntry struct {
+	// Value is the result of the resolver
+	Value any
+
+	// Expires is the time after which the result is stale
+	Expires time.Time
+}
+
+type fieldCacheCall struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.FieldResultCache
+	graphql.HandlerExtension
+} = &FieldCache{}
+
+func (c *FieldCache) ExtensionName() string {
+	return "FieldCache"
+}
+
+func (c *FieldCache) Validate(schema graphql.ExecutableSchema) error {
+	if c.Cache == nil {
+		return errors.New("FieldCache.Cache can not be nil")
+	}
+	c.calls = map[string]*fieldCacheCall{}
+	return nil
+}
+
+func (c *FieldCache) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	return next(graphql.WithFieldResultCache(ctx, c))
+}
+
+func (c *FieldCache) Resolve(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	next graphql.Resolver,
+) (any, error) {
+	if entry, ok := c.Cache.Get(ctx, key); ok && entry != nil &&
+		graphql.Now().Before(entry.Expires) {
+		return entry.Value, nil
+	}
+
+	c.mu.Lock()
+	for {
+		call, ok := c.calls[key]
+		if !ok {
+			break
+		}
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if !isContextError(call.err) {
+			return call.value, call.err
+		}
+		// the request that resolved the field went away, which says nothing about this one
+		c.mu.Lock()
+	}
+	call := &fieldCacheCall{
+		done: make(chan struct{}),
+		err:  errors.New("cached resolver panicked"),
+	}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+		c.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.value, call.err = next(ctx)
+	if call.err == nil {
+		c.Cache.Add(ctx, key, &FieldCacheEntry{
+			Value:   call.value,
+			Expires: graphql.Now().Add(ttl),
+		})
+	}
+	return call.value, call.err
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
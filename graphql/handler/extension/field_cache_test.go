@@ -0,0 +1,128 @@
+package extension_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+)
+
+func TestFieldCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time { return now }
+	defer func() { graphql.Now = time.Now }()
+
+	ctx := context.Background()
+	c := &extension.FieldCache{Cache: graphql.MapCache[*extension.FieldCacheEntry]{}}
+	require.NoError(t, c.Validate(nil))
+
+	calls := 0
+	next := func(ctx context.Context) (any, error) {
+		calls++
+		return calls, nil
+	}
+
+	res, err := c.Resolve(ctx, "Query.rates{}", time.Minute, next)
+	require.NoError(t, err)
+	require.Equal(t, 1, res)
+
+	res, err = c.Resolve(ctx, "Query.rates{}", time.Minute, next)
+	require.NoError(t, err)
+	require.Equal(t, 1, res, "results are memoized")
+
+	res, err = c.Resolve(ctx, `Query.rates{"currency":"EUR"}`, time.Minute, next)
+	require.NoError(t, err)
+	require.Equal(t, 2, res, "keys are cached separately")
+
+	now = now.Add(time.Minute)
+	res, err = c.Resolve(ctx, "Query.rates{}", time.Minute, next)
+	require.NoError(t, err)
+	require.Equal(t, 3, res, "stale results are resolved again")
+
+	failing := func(ctx context.Context) (any, error) {
+		calls++
+		return nil, errors.New("unavailable")
+	}
+	_, err = c.Resolve(ctx, "Query.config{}", time.Minute, failing)
+	require.EqualError(t, err, "unavailable")
+	_, err = c.Resolve(ctx, "Query.config{}", time.Minute, failing)
+	require.EqualError(t, err, "unavailable")
+	require.Equal(t, 5, calls, "errors are not memoized")
+}
+
+func TestFieldCacheSingleFlight(t *testing.T) {
+	c := &extension.FieldCache{Cache: lru.New[*extension.FieldCacheEntry](10)}
+	require.NoError(t, c.Validate(nil))
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	next := func(ctx context.Context) (any, error) {
+		calls.Add(1)
+		<-release
+		return "rates", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]any, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = c.Resolve(context.Background(), "Query.rates{}", time.Minute, next)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+	for _, res := range results {
+		require.Equal(t, "rates", res)
+	}
+}
+
+func TestFieldCacheCancelledLeader(t *testing.T) {
+	c := &extension.FieldCache{Cache: lru.New[*extension.FieldCacheEntry](10)}
+	require.NoError(t, c.Validate(nil))
+
+	var calls atomic.Int32
+	next := func(ctx context.Context) (any, error) {
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return "rates", nil
+	}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.Resolve(leaderCtx, "Query.rates{}", time.Minute, next)
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	var res any
+	var err error
+	done := make(chan struct{})
+	go func() {
+		res, err = c.Resolve(context.Background(), "Query.rates{}", time.Minute, next)
+		close(done)
+	}()
+	cancel()
+
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+	<-done
+	require.NoError(t, err)
+	require.Equal(t, "rates", res)
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,213 @@
+package extension
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errOperationLimit = "OPERATION_LIMIT_EXCEEDED"
+
+// OperationLimit is a cheap structural guard against abusive operations. Where ComplexityLimit
+// relies on per field complexity functions, OperationLimit only looks at the shape of the
+// operation: how deeply selections are nested, and how many aliases, root fields and fragment
+// spreads it contains once fragments are expanded. Selections excluded by @skip or @include are
+// not counted, and neither are introspection fields.
+//
+// A zero value for any limit disables it. If an operation exceeds a limit it is rejected with a
+// 422 status code.
+type OperationLimit struct {
+	// MaxDepth is the maximum nesting of field selections, a query like { a { b } } has a depth
+	// of 2.
+	MaxDepth int
+	// MaxAliases is the maximum number of aliased fields.
+	MaxAliases int
+	// MaxRootFields is the maximum number of fields selected on the root operation type.
+	MaxRootFields int
+	// MaxFragmentSpreads is the maximum number of fragment spreads, counting each time a
+	// fragment is expanded.
+	MaxFragmentSpreads int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+	errcode.ErrorTypeProvider
+} = OperationLimit{}
+
+const operationLimitExtension = "OperationLimit"
+
+type OperationLimitStats struct {
+	// Depth is the deepest nesting of field selections in the operation
+	Depth int
+
+	// Aliases is the number of aliased fields in the operation
+	Aliases int
+
+	// RootFields is the number of fields selected on the root operation type
+	RootFields int
+
+	// FragmentSpreads is the number of fragment spreads in the operation, after expansion
+	FragmentSpreads int
+}
+
+func (o OperationLimit) ExtensionName() string {
+	return operationLimitExtension
+}
+
+func (o OperationLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (o OperationLimit) ErrorTypes() map[string]errcode.ErrorType {
+	return map[string]errcode.ErrorType{errOperationLimit: {Kind: errcode.KindProtocol}}
+}
+
+func (o OperationLimit) MutateOperationContext(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) *gqlerror.Error {
+	w := operationLimitWalker{
+		doc:       opCtx.Doc,
+		vars:      opCtx.Variables,
+		fragments: map[string]*selectionStats{},
+	}
+	measured := w.walk(opCtx.Operation.SelectionSet)
+	stats := &OperationLimitStats{
+		Depth:           measured.depth,
+		Aliases:         measured.aliases,
+		RootFields:      measured.fields,
+		FragmentSpreads: measured.spreads,
+	}
+
+	opCtx.Stats.SetExtension(operationLimitExtension, stats)
+
+	var err *gqlerror.Error
+	switch {
+	case exceeds(stats.Depth, o.MaxDepth):
+		err = gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d",
+			stats.Depth, o.MaxDepth)
+	case exceeds(stats.Aliases, o.MaxAliases):
+		err = gqlerror.Errorf("operation has %d aliases, which exceeds the limit of %d",
+			stats.Aliases, o.MaxAliases)
+	case exceeds(stats.RootFields, o.MaxRootFields):
+		err = gqlerror.Errorf("operation has %d root fields, which exceeds the limit of %d",
+			stats.RootFields, o.MaxRootFields)
+	case exceeds(stats.FragmentSpreads, o.MaxFragmentSpreads):
+		err = gqlerror.Errorf("operation has %d fragment spreads, which exceeds the limit of %d",
+			stats.FragmentSpreads, o.MaxFragmentSpreads)
+	default:
+		return nil
+	}
+
+	errcode.Set(err, errOperationLimit)
+	return err
+}
+
+func GetOperationLimitStats(ctx context.Context) *OperationLimitStats {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(operationLimitExtension).(*OperationLimitStats)
+	return s
+}
+
+func exceeds(value, limit int) bool {
+	return limit > 0 && value > limit
+}
+
+type operationLimitWalker struct {
+	doc       *ast.QueryDocument
+	vars      map[string]any
+	fragments map[string]*selectionStats
+}
+
+// selectionStats measures a selection set relative to its own level: depth is 1 for a selection
+// set holding only leaf fields, and fields counts the fields selected at that level.
+type selectionStats struct {
+	depth   int
+	aliases int
+	fields  int
+	spreads int
+}
+
+func (s *selectionStats) merge(o *selectionStats) {
+	s.depth = max(s.depth, o.depth)
+	s.aliases = saturatingAdd(s.aliases, o.aliases)
+	s.fields = saturatingAdd(s.fields, o.fields)
+	s.spreads = saturatingAdd(s.spreads, o.spreads)
+}
+
+// walk measures selectionSet. Each fragment is measured once and its stats reused for every
+// spread, so a small document spreading fragments that spread each other many times can not make
+// it spin, whichever limits are set. Counts that would overflow are capped at math.MaxInt.
+func (w *operationLimitWalker) walk(selectionSet ast.SelectionSet) *selectionStats {
+	stats := &selectionStats{}
+	for _, sel := range selectionSet {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if !graphql.ShouldIncludeNode(sel.Directives, w.vars) ||
+				strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			if sel.Alias != sel.Name {
+				stats.aliases = saturatingAdd(stats.aliases, 1)
+			}
+			stats.fields = saturatingAdd(stats.fields, 1)
+
+			child := w.walk(sel.SelectionSet)
+			stats.depth = max(stats.depth, child.depth+1)
+			stats.aliases = saturatingAdd(stats.aliases, child.aliases)
+			stats.spreads = saturatingAdd(stats.spreads, child.spreads)
+
+		case *ast.InlineFragment:
+			if !graphql.ShouldIncludeNode(sel.Directives, w.vars) {
+				continue
+			}
+			stats.merge(w.walk(sel.SelectionSet))
+
+		case *ast.FragmentSpread:
+			if !graphql.ShouldIncludeNode(sel.Directives, w.vars) {
+				continue
+			}
+			stats.spreads = saturatingAdd(stats.spreads, 1)
+			stats.merge(w.walkFragment(sel.Name))
+		}
+	}
+	return stats
+}
+
+func (w *operationLimitWalker) walkFragment(name string) *selectionStats {
+	if stats, ok := w.fragments[name]; ok {
+		// validation rejects fragment cycles, but guard against them regardless: a fragment
+		// being measured is nil until it is done
+		if stats == nil {
+			return &selectionStats{}
+		}
+		return stats
+	}
+
+	fragment := w.doc.Fragments.ForName(name)
+	if fragment == nil {
+		return &selectionStats{}
+	}
+	w.fragments[name] = nil
+	stats := w.walk(fragment.SelectionSet)
+	w.fragments[name] = stats
+	return stats
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
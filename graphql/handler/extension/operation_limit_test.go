@@ -0,0 +1,166 @@
+package extension_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestOperationLimit(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query {
+			user(id: ID!): User
+			users: [User!]!
+		}
+		type User {
+			name: String!
+			friends: [User!]!
+		}
+	`})
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		limits    extension.OperationLimit
+		stats     extension.OperationLimitStats
+		err       string
+	}{
+		{
+			name:  "measures a simple query",
+			query: `{ user(id: 1) { name friends { name } } }`,
+			stats: extension.OperationLimitStats{Depth: 3, RootFields: 1},
+		},
+		{
+			name: "expands fragments",
+			query: `
+				query { a: user(id: 1) { ...F } b: user(id: 2) { ...F } }
+				fragment F on User { friends { ...G } }
+				fragment G on User { friends { name } }
+			`,
+			stats: extension.OperationLimitStats{
+				Depth: 4, Aliases: 2, RootFields: 2, FragmentSpreads: 4,
+			},
+		},
+		{
+			name: "skips excluded selections",
+			query: `query($skip: Boolean!) {
+				users { name }
+				user(id: 1) @skip(if: $skip) { friends { friends { name } } }
+				... @include(if: false) { other: users { name } }
+			}`,
+			variables: map[string]any{"skip": true},
+			stats:     extension.OperationLimitStats{Depth: 2, RootFields: 1},
+		},
+		{
+			name:  "ignores introspection",
+			query: `{ __typename __schema { types { name fields { name } } } users { name } }`,
+			stats: extension.OperationLimitStats{Depth: 2, RootFields: 1},
+		},
+		{
+			name:   "depth limit",
+			query:  `{ users { friends { friends { name } } } }`,
+			limits: extension.OperationLimit{MaxDepth: 3},
+			stats:  extension.OperationLimitStats{Depth: 4, RootFields: 1},
+			err:    "operation has depth 4, which exceeds the limit of 3",
+		},
+		{
+			name:   "alias limit",
+			query:  `{ a: users { name } b: users { n: name } }`,
+			limits: extension.OperationLimit{MaxAliases: 2},
+			stats:  extension.OperationLimitStats{Depth: 2, Aliases: 3, RootFields: 2},
+			err:    "operation has 3 aliases, which exceeds the limit of 2",
+		},
+		{
+			name:   "root field limit",
+			query:  `{ users { name } user(id: 1) { name } }`,
+			limits: extension.OperationLimit{MaxRootFields: 1},
+			stats:  extension.OperationLimitStats{Depth: 2, RootFields: 2},
+			err:    "operation has 2 root fields, which exceeds the limit of 1",
+		},
+		{
+			name: "fragment spread limit",
+			query: `
+				query { users { ...A ...A ...A } }
+				fragment A on User { ...B ...B ...B }
+				fragment B on User { name }
+			`,
+			limits: extension.OperationLimit{MaxFragmentSpreads: 2},
+			stats:  extension.OperationLimitStats{Depth: 2, RootFields: 1, FragmentSpreads: 12},
+			err:    "operation has 12 fragment spreads, which exceeds the limit of 2",
+		},
+		{
+			name: "measures each fragment once",
+			query: `query { users { ...F0 } }
+				` + nestedFragments(40) + `
+				fragment F40 on User { a: name }
+			`,
+			limits: extension.OperationLimit{MaxDepth: 2},
+			stats: extension.OperationLimitStats{
+				Depth: 2, Aliases: math.MaxInt, RootFields: 1, FragmentSpreads: math.MaxInt,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(schema, tc.query)
+			require.Empty(t, errs)
+
+			opCtx := &graphql.OperationContext{
+				Doc:       doc,
+				Operation: doc.Operations[0],
+				Variables: tc.variables,
+			}
+			ctx := graphql.WithOperationContext(context.Background(), opCtx)
+
+			gqlErr := tc.limits.MutateOperationContext(ctx, opCtx)
+			if tc.err == "" {
+				require.Nil(t, gqlErr)
+			} else {
+				require.NotNil(t, gqlErr)
+				require.Equal(t, tc.err, gqlErr.Message)
+				require.Equal(t, "OPERATION_LIMIT_EXCEEDED", gqlErr.Extensions["code"])
+			}
+			require.Equal(t, &tc.stats, extension.GetOperationLimitStats(ctx))
+		})
+	}
+}
+
+// nestedFragments returns fragments F0 to F(n-1), each spreading the next one 4 times.
+func nestedFragments(n int) string {
+	var sb strings.Builder
+	for i := range n {
+		next := i + 1
+		fmt.Fprintf(&sb, "fragment F%d on User { ...F%d ...F%d ...F%d ...F%d }\n",
+			i, next, next, next, next)
+	}
+	return sb.String()
+}
+
+func TestOperationLimitIntegration(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.OperationLimit{MaxAliases: 1})
+	h.AddTransport(&transport.POST{})
+
+	resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ a: name }"}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"{ a: name b: name }"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+	require.JSONEq(t,
+		`{"errors":[{"message":"operation has 2 aliases, which exceeds the limit of 1","extensions":{"code":"OPERATION_LIMIT_EXCEEDED"}}],"data":null}`,
+		resp.Body.String())
+}
@@ -0,0 +1,282 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errRateLimited = "RATE_LIMITED"
+
+const rateLimitExtension = "ComplexityRateLimit"
+
+// ComplexityRateLimit gives every client a budget of complexity points that refills over time,
+// and deducts the complexity of each operation from it. Where ComplexityLimit rejects single
+// operations that are too expensive, ComplexityRateLimit stops clients from sending many cheap
+// operations.
+//
+// Budgets are token buckets holding up to Budget points, which refill at a rate of Budget points
+// per Window. Operations costing more than the points left in the bucket are rejected with the
+// RATE_LIMITED error code. The state of the bucket is reported in the rateLimit response
+// extension and, on HTTP transports, in the RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers, plus Retry-After for rejected operations.
+type ComplexityRateLimit struct {
+	// ClientKey identifies the client sending the operation, for example by an API key header,
+	// its IP address or the subject of its access token. Operations for which it returns an
+	// empty string are not limited.
+	ClientKey func(ctx context.Context, opCtx *graphql.OperationContext) string
+
+	// Budget is the number of complexity points a client can spend per Window.
+	Budget int
+
+	// Window is the time it takes for an empty budget to refill completely.
+	Window time.Duration
+
+	// Store holds the budget of each client. Operations are rejected if it returns an error.
+	Store RateLimitStore
+
+	// Options are passed to complexity.Calculate. They are unused when the operation complexity
+	// has already been calculated by a ComplexityLimit extension added before this one.
+	Options []complexity.Option
+
+	es graphql.ExecutableSchema
+}
+
+// RateLimitStore holds the complexity budgets of clients. Implementations must be safe for
+// concurrent use.
+type RateLimitStore interface {
+	// Spend deducts cost from the budget of the client identified by key, unless the budget has
+	// fewer than cost points left. Budgets hold up to limit points and refill completely in
+	// window. now is the time of the operation.
+	Spend(
+		ctx context.Context,
+		key string,
+		cost, limit int,
+		window time.Duration,
+		now time.Time,
+	) (RateLimitResult, error)
+}
+
+// RateLimitResult is the state of a client budget after an operation.
+type RateLimitResult struct {
+	// Allowed is true if the cost was deducted from the budget
+	Allowed bool
+
+	// Remaining is the number of points left in the budget
+	Remaining int
+
+	// Reset is the time until the budget is full again
+	Reset time.Duration
+
+	// RetryAfter is the time until the budget holds enough points for a rejected operation
+	RetryAfter time.Duration
+}
+
+type RateLimitStats struct {
+	// Client is the key identifying the client
+	Client string
+
+	// Cost is the complexity of the operation
+	Cost int
+
+	// Limit is the budget of the client
+	Limit int
+
+	RateLimitResult
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+	errcode.ErrorTypeProvider
+} = &ComplexityRateLimit{}
+
+func (c ComplexityRateLimit) ExtensionName() string {
+	return rateLimitExtension
+}
+
+func (c *ComplexityRateLimit) Validate(schema graphql.ExecutableSchema) error {
+	if c.ClientKey == nil {
+		return errors.New("ComplexityRateLimit.ClientKey can not be nil")
+	}
+	if c.Store == nil {
+		return errors.New("ComplexityRateLimit.Store can not be nil")
+	}
+	if c.Budget <= 0 || c.Window <= 0 {
+		return errors.New("ComplexityRateLimit.Budget and Window must be positive")
+	}
+	c.es = schema
+	return nil
+}
+
+// ErrorTypes reports rejected operations with a 429 Too Many Requests status.
+func (c ComplexityRateLimit) ErrorTypes() map[string]errcode.ErrorType {
+	return map[string]errcode.ErrorType{
+		errRateLimited: {Kind: errcode.KindProtocol, Status: http.StatusTooManyRequests},
+	}
+}
+
+func (c ComplexityRateLimit) MutateOperationContext(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) *gqlerror.Error {
+	client := c.ClientKey(ctx, opCtx)
+	if client == "" {
+		return nil
+	}
+
+	var cost int
+	if stats := GetComplexityStats(ctx); stats != nil {
+		cost = stats.Complexity
+	} else {
+		cost = complexity.Calculate(ctx, c.es, opCtx.Operation, opCtx.Variables, c.Options...)
+	}
+
+	res, err := c.Store.Spend(ctx, client, cost, c.Budget, c.Window, graphql.Now())
+	if err != nil {
+		// the store error may describe its backend, so it is wrapped for logging instead of
+		// being sent to the client
+		return &gqlerror.Error{Message: "unable to check rate limit", Err: err}
+	}
+
+	opCtx.Stats.SetExtension(rateLimitExtension, &RateLimitStats{
+		Client:          client,
+		Cost:            cost,
+		Limit:           c.Budget,
+		RateLimitResult: res,
+	})
+
+	if header := graphql.GetResponseHeader(ctx); header != nil {
+		header.Set("RateLimit-Limit", strconv.Itoa(c.Budget))
+		header.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+		if !res.Allowed {
+			header.Set("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+		}
+	}
+
+	if !res.Allowed {
+		err := gqlerror.Errorf(
+			"operation has complexity %d, which exceeds the remaining rate limit budget of %d",
+			cost,
+			res.Remaining,
+		)
+		errcode.Set(err, errRateLimited)
+		return err
+	}
+
+	return nil
+}
+
+func (c ComplexityRateLimit) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	if stats := GetRateLimitStats(ctx); stats != nil {
+		graphql.RegisterExtension(ctx, "rateLimit", map[string]any{
+			"cost":      stats.Cost,
+			"limit":     stats.Limit,
+			"remaining": stats.Remaining,
+			"reset":     ceilSeconds(stats.Reset),
+		})
+	}
+	return next(ctx)
+}
+
+func GetRateLimitStats(ctx context.Context) *RateLimitStats {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(rateLimitExtension).(*RateLimitStats)
+	return s
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// InMemoryRateLimitStore is a RateLimitStore keeping budgets in process memory. Budgets that
+// have refilled completely are forgotten, so its size is bounded by the number of clients active
+// within a window.
+type InMemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*rateLimitBucket
+	lastSweep time.Time
+}
+
+type rateLimitBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+var _ RateLimitStore = &InMemoryRateLimitStore{}
+
+// NewInMemoryRateLimitStore returns an empty InMemoryRateLimitStore.
+func NewInMemoryRateLimitStore() *InMemoryRateLimitStore {
+	return &InMemoryRateLimitStore{buckets: map[string]*rateLimitBucket{}}
+}
+
+func (s *InMemoryRateLimitStore) Spend(
+	ctx context.Context,
+	key string,
+	cost, limit int,
+	window time.Duration,
+	now time.Time,
+) (RateLimitResult, error) {
+	rate := float64(limit) / window.Seconds()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= window {
+		for k, b := range s.buckets {
+			if b.tokens+now.Sub(b.updated).Seconds()*rate >= float64(limit) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &rateLimitBucket{tokens: float64(limit), updated: now}
+		s.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(float64(limit), b.tokens+elapsed.Seconds()*rate)
+		b.updated = now
+	}
+
+	res := RateLimitResult{}
+	if float64(cost) <= b.tokens {
+		b.tokens -= float64(cost)
+		res.Allowed = true
+	} else {
+		res.RetryAfter = secondsDuration((float64(cost) - b.tokens) / rate)
+	}
+	res.Remaining = int(b.tokens)
+	res.Reset = secondsDuration((float64(limit) - b.tokens) / rate)
+
+	return res, nil
+}
+
+func secondsDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
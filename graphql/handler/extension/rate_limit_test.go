@@ -0,0 +1,127 @@
+package extension_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestComplexityRateLimit(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time { return now }
+	defer func() { graphql.Now = time.Now }()
+
+	h := testserver.New()
+	h.Use(&extension.ComplexityRateLimit{
+		ClientKey: func(ctx context.Context, opCtx *graphql.OperationContext) string {
+			return opCtx.Headers.Get("Content-Type")
+		},
+		Budget: 10,
+		Window: 10 * time.Second,
+		Store:  extension.NewInMemoryRateLimitStore(),
+	})
+	h.AddTransport(&transport.POST{})
+	h.SetCalculatedComplexity(4)
+
+	resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.JSONEq(t,
+		`{"data":{"name":"test"},"extensions":{"rateLimit":{"cost":4,"limit":10,"remaining":6,"reset":4}}}`,
+		resp.Body.String())
+	require.Equal(t, "10", resp.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "6", resp.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "4", resp.Header().Get("RateLimit-Reset"))
+
+	resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	require.Equal(t, "2", resp.Header().Get("RateLimit-Remaining"))
+
+	resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	require.Equal(t, http.StatusTooManyRequests, resp.Code)
+	require.JSONEq(t,
+		`{"errors":[{"message":"operation has complexity 4, which exceeds the remaining rate limit budget of 2","extensions":{"code":"RATE_LIMITED"}}],"data":null,"extensions":{"rateLimit":{"cost":4,"limit":10,"remaining":2,"reset":8}}}`,
+		resp.Body.String())
+	require.Equal(t, "2", resp.Header().Get("Retry-After"))
+
+	now = now.Add(2 * time.Second)
+	resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	require.JSONEq(t,
+		`{"data":{"name":"test"},"extensions":{"rateLimit":{"cost":4,"limit":10,"remaining":0,"reset":10}}}`,
+		resp.Body.String())
+}
+
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) Spend(
+	context.Context,
+	string,
+	int, int,
+	time.Duration,
+	time.Time,
+) (extension.RateLimitResult, error) {
+	return extension.RateLimitResult{}, errors.New("dial tcp 10.0.0.1:6379: connection refused")
+}
+
+func TestComplexityRateLimitStoreError(t *testing.T) {
+	h := testserver.New()
+	h.Use(&extension.ComplexityRateLimit{
+		ClientKey: func(ctx context.Context, opCtx *graphql.OperationContext) string {
+			return "client"
+		},
+		Budget: 10,
+		Window: 10 * time.Second,
+		Store:  failingRateLimitStore{},
+	})
+	h.AddTransport(&transport.POST{})
+
+	resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	require.NotContains(t, resp.Body.String(), "10.0.0.1")
+	require.JSONEq(t,
+		`{"errors":[{"message":"unable to check rate limit"}],"data":null}`,
+		resp.Body.String())
+}
+
+func TestInMemoryRateLimitStore(t *testing.T) {
+	ctx := context.Background()
+	store := extension.NewInMemoryRateLimitStore()
+	start := time.Unix(0, 0)
+
+	res, err := store.Spend(ctx, "a", 60, 100, time.Minute, start)
+	require.NoError(t, err)
+	require.Equal(t, extension.RateLimitResult{
+		Allowed:   true,
+		Remaining: 40,
+		Reset:     36 * time.Second,
+	}, res)
+
+	res, err = store.Spend(ctx, "a", 60, 100, time.Minute, start)
+	require.NoError(t, err)
+	require.Equal(t, extension.RateLimitResult{
+		Remaining:  40,
+		Reset:      36 * time.Second,
+		RetryAfter: 12 * time.Second,
+	}, res)
+
+	res, err = store.Spend(ctx, "b", 60, 100, time.Minute, start)
+	require.NoError(t, err)
+	require.True(t, res.Allowed, "budgets are per client")
+
+	res, err = store.Spend(ctx, "a", 60, 100, time.Minute, start.Add(12*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, extension.RateLimitResult{
+		Allowed: true,
+		Reset:   time.Minute,
+	}, res)
+
+	res, err = store.Spend(ctx, "a", 10, 100, time.Minute, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 90, res.Remaining, "budgets never exceed the limit")
+}
@@ -0,0 +1,236 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const responseCacheExtension = "ResponseCache"
+
+// ResponseCache stores complete query responses in a graphql.Cache, and answers repeated queries
+// from it without executing them. Responses are keyed by a hash of the normalized query document,
+// the operation name, the variables and the result of Vary.
+//
+// How long a response is stored is decided by TTL and the cache policy computed by a CacheControl
+// extension, whichever is shorter. Responses with a private scope are only stored when
+// SessionID identifies the user, and are only served back to that user. Mutations,
+// subscriptions, incremental responses and responses with errors are never stored.
+//
+// graphql.Cache has no expiry, so entries are checked for staleness when they are read; use a
+// cache that evicts entries, such as lru.LRU, to bound its size. Responses served from the cache
+// do not carry the response extensions of the original operation.
+type ResponseCache struct {
+	// Cache stores the responses.
+	Cache graphql.Cache[*ResponseCacheEntry]
+
+	// TTL is how long responses are stored for. When the CacheControl extension computed a
+	// policy, responses are stored for at most its maxAge, and not at all if it is 0. If TTL is
+	// zero, responses are stored for the maxAge, and not stored at all without CacheControl.
+	TTL time.Duration
+
+	// Vary returns a string that is added to the cache key of every response, to store
+	// different responses for requests that differ in other ways than the query and its
+	// variables, for example by locale.
+	Vary func(ctx context.Context, opCtx *graphql.OperationContext) string
+
+	// SessionID identifies the user sending the operation, for example by the subject of their
+	// access token. Responses with a private scope are stored per session, and not at all if it
+	// is nil or returns an empty string.
+	SessionID func(ctx context.Context, opCtx *graphql.OperationContext) string
+}
+
+// ResponseCacheEntry is a response stored by ResponseCache.
+type ResponseCacheEntry struct {
+	// Data is the data of the response
+	Data json.RawMessage
+
+	// Policy is the cache policy computed for the response by the CacheControl extension, if any
+	Policy *CachePolicy
+
+	// Stored is the time the response was stored at
+	Stored time.Time
+
+	// Expires is the time after which the response is stale
+	Expires time.Time
+}
+
+type ResponseCacheStats struct {
+	// Hit is true if the response was served from the cache
+	Hit bool
+
+	// Key is the cache key of the response, without the session
+	Key string
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = ResponseCache{}
+
+func (c ResponseCache) ExtensionName() string {
+	return responseCacheExtension
+}
+
+func (c ResponseCache) Validate(schema graphql.ExecutableSchema) error {
+	if c.Cache == nil {
+		return errors.New("ResponseCache.Cache can not be nil")
+	}
+	if c.TTL < 0 {
+		return errors.New("ResponseCache.TTL can not be negative")
+	}
+	return nil
+}
+
+func (c ResponseCache) InterceptOperation(
+	ctx context.Context,
+	next graphql.OperationHandler,
+) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query {
+		return next(ctx)
+	}
+
+	key, err := c.cacheKey(ctx, opCtx)
+	if err != nil {
+		// variables that can not be serialized, such as uploads, are not cached
+		return next(ctx)
+	}
+	var session string
+	if c.SessionID != nil {
+		session = c.SessionID(ctx, opCtx)
+	}
+
+	stats := &ResponseCacheStats{Key: key}
+	opCtx.Stats.SetExtension(responseCacheExtension, stats)
+
+	now := graphql.Now()
+	if entry, ok := c.lookup(ctx, key, session, now); ok {
+		stats.Hit = true
+		if header := graphql.GetResponseHeader(ctx); header != nil && entry.Policy != nil {
+			age := int(now.Sub(entry.Stored).Seconds())
+			if maxAge := entry.Policy.MaxAge - age; maxAge > 0 {
+				header.Set("Cache-Control", fmt.Sprintf("max-age=%d, %s",
+					maxAge, strings.ToLower(string(entry.Policy.Scope))))
+			}
+		}
+		return graphql.OneShot(&graphql.Response{Data: entry.Data})
+	}
+
+	responses := next(ctx)
+	first := true
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if !first || resp == nil {
+			return resp
+		}
+		first = false
+		c.store(ctx, key, session, resp)
+		return resp
+	}
+}
+
+func (c ResponseCache) lookup(
+	ctx context.Context,
+	key, session string,
+	now time.Time,
+) (*ResponseCacheEntry, bool) {
+	keys := []string{key}
+	if session != "" {
+		keys = []string{sessionCacheKey(key, session), key}
+	}
+	for _, k := range keys {
+		entry, ok := c.Cache.Get(ctx, k)
+		if ok && entry != nil && now.Before(entry.Expires) {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+func (c ResponseCache) store(
+	ctx context.Context,
+	key, session string,
+	resp *graphql.Response,
+) {
+	if len(resp.Errors) > 0 || resp.HasNext != nil {
+		return
+	}
+
+	policy := GetCachePolicy(ctx)
+	ttl := c.TTL
+	if policy != nil {
+		maxAge := time.Duration(policy.MaxAge) * time.Second
+		if ttl == 0 || maxAge < ttl {
+			ttl = maxAge
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	if policy != nil && policy.Scope == CacheScopePrivate {
+		if session == "" {
+			return
+		}
+		key = sessionCacheKey(key, session)
+	}
+
+	now := graphql.Now()
+	c.Cache.Add(ctx, key, &ResponseCacheEntry{
+		Data:    resp.Data,
+		Policy:  policy,
+		Stored:  now,
+		Expires: now.Add(ttl),
+	})
+}
+
+// cacheKey hashes the operation in a form that does not depend on the formatting of the query
+// document or the order of the variables.
+func (c ResponseCache) cacheKey(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) (string, error) {
+	variables, err := json.Marshal(opCtx.Variables)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize variables: %w", err)
+	}
+
+	var query bytes.Buffer
+	formatter.NewFormatter(&query, formatter.WithCompacted()).FormatQueryDocument(opCtx.Doc)
+
+	h := sha256.New()
+	for _, part := range [][]byte{query.Bytes(), []byte(opCtx.OperationName), variables} {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	if c.Vary != nil {
+		h.Write([]byte(c.Vary(ctx, opCtx)))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sessionCacheKey hashes the session, so session identifiers are not exposed to the cache store.
+func sessionCacheKey(key, session string) string {
+	return key + ":" + computeQueryHash(session)
+}
+
+func GetResponseCacheStats(ctx context.Context) *ResponseCacheStats {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(responseCacheExtension).(*ResponseCacheStats)
+	return s
+}
@@ -0,0 +1,142 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestResponseCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time { return now }
+	defer func() { graphql.Now = time.Now }()
+
+	t.Run("stores responses for the TTL", func(t *testing.T) {
+		var hit bool
+		executed := 0
+		h := testserver.New()
+		h.AroundOperations(
+			func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+				responses := next(ctx)
+				hit = extension.GetResponseCacheStats(ctx).Hit
+				return responses
+			})
+		h.Use(extension.ResponseCache{
+			Cache: lru.New[*extension.ResponseCacheEntry](10),
+			TTL:   time.Minute,
+		})
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			executed++
+			return next(ctx)
+		})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, 1, executed)
+
+		resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"query {\n  name\n}"}`)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, 1, executed, "differently formatted queries share an entry")
+
+		resp = doRequest(h, http.MethodPost, "/graphql",
+			`{"query":"query($id: Int!) { find(id: $id) }","variables":{"id":1}}`)
+		require.Equal(t, 2, executed)
+		require.False(t, hit)
+		resp = doRequest(h, http.MethodPost, "/graphql",
+			`{"query":"query($id: Int!) { find(id: $id) }","variables":{"id":1}}`)
+		require.Equal(t, 2, executed)
+		require.True(t, hit)
+		resp = doRequest(h, http.MethodPost, "/graphql",
+			`{"query":"query($id: Int!) { find(id: $id) }","variables":{"id":2}}`)
+		require.Equal(t, 3, executed, "variables are part of the key")
+
+		now = now.Add(time.Minute)
+		resp = doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, 4, executed, "stale entries are not served")
+	})
+
+	t.Run("honors the cache policy", func(t *testing.T) {
+		executed := 0
+		h := testserver.New()
+		h.Use(&extension.CacheControl{DefaultMaxAge: 30})
+		h.Use(extension.ResponseCache{
+			Cache: lru.New[*extension.ResponseCacheEntry](10),
+			SessionID: func(ctx context.Context, opCtx *graphql.OperationContext) string {
+				return opCtx.Headers.Get("X-User")
+			},
+		})
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			executed++
+			extension.SetCacheHint(ctx, 30, extension.CacheScopePrivate)
+			return next(ctx)
+		})
+		h.AddTransport(&transport.POST{})
+
+		request := func(user string) *httptest.ResponseRecorder {
+			body := strings.NewReader(`{"query":"{ name }"}`)
+			r := httptest.NewRequest(http.MethodPost, "/graphql", body)
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("X-User", user)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			return w
+		}
+
+		request("")
+		request("")
+		require.Equal(t, 2, executed, "private responses need a session")
+
+		resp := request("alice")
+		require.Equal(t, "max-age=30, private", resp.Header().Get("Cache-Control"))
+		now = now.Add(10 * time.Second)
+		resp = request("alice")
+		require.Equal(t, 3, executed)
+		require.Equal(t, "max-age=20, private", resp.Header().Get("Cache-Control"))
+
+		request("bob")
+		require.Equal(t, 4, executed, "private responses are stored per session")
+	})
+	t.Run("stores responses for at most the policy maxAge", func(t *testing.T) {
+		maxAge := 10
+		executed := 0
+		h := testserver.New()
+		h.Use(&extension.CacheControl{})
+		h.Use(extension.ResponseCache{
+			Cache: lru.New[*extension.ResponseCacheEntry](10),
+			TTL:   time.Minute,
+		})
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			executed++
+			extension.SetCacheHint(ctx, maxAge, extension.CacheScopePublic)
+			return next(ctx)
+		})
+		h.AddTransport(&transport.POST{})
+
+		doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, 1, executed)
+
+		now = now.Add(10 * time.Second)
+		doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, 2, executed, "the maxAge is shorter than the TTL")
+
+		maxAge = 0
+		now = now.Add(10 * time.Second)
+		doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, 4, executed, "a maxAge of 0 is never stored")
+	})
+}
@@ -0,0 +1,99 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SchemaVisibility hides parts of the schema from some clients. Hidden types, fields, arguments,
+// input fields and enum values are left out of introspection, and operations using them fail
+// validation as if they did not exist, so one server can expose both a public and an internal
+// schema.
+//
+// Clients are grouped into audiences, and each audience sees the schema elements for which
+// Visible returns true. Fields and arguments of hidden types are hidden too, as are types left
+// without fields or values. Visible schemas are computed once per audience, so audiences should
+// be a small set such as roles, not individual users.
+type SchemaVisibility struct {
+	// Audience returns the audience of the operation, for example based on the roles of the user
+	// found in ctx. An empty audience sees the whole schema.
+	Audience func(ctx context.Context, rawParams *graphql.RawParams) string
+
+	// Visible reports whether the schema element at coordinate, with the given directives, is
+	// visible to audience. Coordinates are schema coordinates such as "User", "User.email",
+	// "Query.users(role:)" or "Role.ADMIN".
+	Visible func(audience, coordinate string, directives ast.DirectiveList) bool
+
+	schema  *ast.Schema
+	mu      sync.Mutex
+	schemas map[string]*ast.Schema
+}
+
+// HideDirective returns a SchemaVisibility.Visible func that hides schema elements annotated
+// with the directive, such as @internal or @inaccessible, from every audience except those
+// listed.
+func HideDirective(
+	directive string,
+	except ...string,
+) func(audience, coordinate string, directives ast.DirectiveList) bool {
+	return func(audience, coordinate string, directives ast.DirectiveList) bool {
+		return directives.ForName(directive) == nil || slices.Contains(except, audience)
+	}
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &SchemaVisibility{}
+
+func (v *SchemaVisibility) ExtensionName() string {
+	return "SchemaVisibility"
+}
+
+func (v *SchemaVisibility) Validate(schema graphql.ExecutableSchema) error {
+	if v.Audience == nil {
+		return errors.New("SchemaVisibility.Audience can not be nil")
+	}
+	if v.Visible == nil {
+		return errors.New("SchemaVisibility.Visible can not be nil")
+	}
+	v.schema = schema.Schema()
+	v.schemas = map[string]*ast.Schema{}
+	return nil
+}
+
+func (v *SchemaVisibility) MutateOperationParameters(
+	ctx context.Context,
+	rawParams *graphql.RawParams,
+) *gqlerror.Error {
+	audience := v.Audience(ctx, rawParams)
+	if audience == "" {
+		return nil
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	opCtx.VisibleSchema = v.visibleSchema(audience)
+	opCtx.VisibleSchemaKey = "visibility:" + audience
+	return nil
+}
+
+func (v *SchemaVisibility) visibleSchema(audience string) *ast.Schema {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if schema, ok := v.schemas[audience]; ok {
+		return schema
+	}
+	schema := graphql.FilterSchema(v.schema, func(coordinate string, directives ast.DirectiveList) bool {
+		return v.Visible(audience, coordinate, directives)
+	})
+	v.schemas[audience] = schema
+	return schema
+}
@@ -0,0 +1,120 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSchemaVisibility(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @internal on OBJECT | FIELD_DEFINITION | ARGUMENT_DEFINITION | ENUM_VALUE
+
+		type Query {
+			users(role: Role, filter: Filter @internal): [User!]!
+			audit: [AuditLog!]!
+			debug: Debug
+		}
+		type Mutation {
+			reindex: Boolean! @internal
+		}
+		type User {
+			name: String!
+			email: String! @internal
+		}
+		type AuditLog @internal {
+			message: String!
+		}
+		type Debug {
+			log: AuditLog
+		}
+		enum Role { ADMIN USER SERVICE @internal }
+		input Filter { name: String }
+	`})
+
+	es := &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+		},
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+	}
+	visibility := &extension.SchemaVisibility{
+		Audience: func(ctx context.Context, rawParams *graphql.RawParams) string {
+			return rawParams.Headers.Get("X-Audience")
+		},
+		Visible: extension.HideDirective("internal", "internal"),
+	}
+
+	t.Run("filters the schema", func(t *testing.T) {
+		require.NoError(t, visibility.Validate(es))
+
+		opCtx := &graphql.OperationContext{}
+		ctx := graphql.WithOperationContext(context.Background(), opCtx)
+		err := visibility.MutateOperationParameters(ctx, &graphql.RawParams{
+			Headers: http.Header{"X-Audience": []string{"public"}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "visibility:public", opCtx.VisibleSchemaKey)
+
+		visible := opCtx.VisibleSchema
+		require.NotNil(t, visible)
+		require.Nil(t, visible.Mutation, "types without visible fields are hidden")
+		require.NotContains(t, visible.Types, "AuditLog")
+		require.NotContains(t, visible.Types, "Debug")
+		require.Contains(t, visible.Types, "Filter")
+
+		var fields []string
+		for _, f := range visible.Query.Fields {
+			if !strings.HasPrefix(f.Name, "__") {
+				fields = append(fields, f.Name)
+			}
+		}
+		require.Equal(t, []string{"users"}, fields)
+		require.Len(t, visible.Query.Fields.ForName("users").Arguments, 1)
+		require.Len(t, visible.Types["User"].Fields, 1)
+		require.Len(t, visible.Types["Role"].EnumValues, 2)
+
+		require.Len(t, schema.Types["User"].Fields, 2, "the original schema is unchanged")
+		require.Len(t, schema.Query.Fields.ForName("users").Arguments, 2)
+	})
+
+	t.Run("hidden fields fail validation", func(t *testing.T) {
+		h := handler.New(es)
+		h.AddTransport(transport.POST{})
+		h.Use(visibility)
+
+		request := func(audience string) *httptest.ResponseRecorder {
+			body := strings.NewReader(`{"query":"{ users(role: SERVICE) { email } }"}`)
+			r := httptest.NewRequest(http.MethodPost, "/graphql", body)
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("X-Audience", audience)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			return w
+		}
+
+		resp := request("internal")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		resp = request("public")
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		require.Contains(t, resp.Body.String(), `Value \"SERVICE\" does not exist in \"Role\" enum.`)
+		require.Contains(t, resp.Body.String(), `Cannot query field \"email\" on type \"User\".`)
+
+		resp = request("")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	})
+}
@@ -0,0 +1,340 @@
+package extension
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const (
+	errPersistedQueryNotInList  = "PERSISTED_QUERY_NOT_IN_LIST"
+	errPersistedQueryIDRequired = "PERSISTED_QUERY_ID_REQUIRED"
+)
+
+const trustedDocumentsExtension = "TrustedDocuments"
+
+const apolloPersistedQueryManifest = "apollo-persisted-query-manifest"
+
+// TrustedDocumentsMode controls what TrustedDocuments does with operations that are not in its
+// manifest.
+type TrustedDocumentsMode int
+
+const (
+	// TrustedDocumentsEnforce rejects every operation that is not in the manifest.
+	TrustedDocumentsEnforce TrustedDocumentsMode = iota
+	// TrustedDocumentsAudit executes operations that are not in the manifest, reporting them to
+	// TrustedDocuments.OnUntrusted. Use it to find the operations missing from a manifest before
+	// enforcing it.
+	TrustedDocumentsAudit
+	// TrustedDocumentsAllowWithHeader executes operations that are not in the manifest only when
+	// the request carries TrustedDocuments.BypassHeader set to TrustedDocuments.BypassToken, for
+	// example for internal tooling. All other untrusted operations are rejected.
+	TrustedDocumentsAllowWithHeader
+)
+
+// TrustedDocuments only executes operations that appear in a manifest of trusted documents,
+// also known as a persisted query allowlist. Unlike AutomaticPersistedQuery, clients can not
+// register new operations: the manifest is produced at build time by the client tooling.
+//
+// Clients send the id of a trusted document in the persistedQuery extension, the same way they
+// do for APQ. The query is then taken from the manifest, so the query cache and executor work
+// unchanged. Do not combine it with AutomaticPersistedQuery, which reads the same extension.
+type TrustedDocuments struct {
+	// Manifest holds the trusted documents, keyed by id.
+	Manifest *TrustedDocumentManifest
+
+	// Mode controls how operations that are not in the manifest are handled.
+	Mode TrustedDocumentsMode
+
+	// BypassHeader and BypassToken allow untrusted operations in the
+	// TrustedDocumentsAllowWithHeader mode.
+	BypassHeader string
+	BypassToken  string
+
+	// OnUntrusted, if set, is called for every untrusted operation, whether or not it was
+	// allowed to execute.
+	OnUntrusted func(ctx context.Context, params *graphql.RawParams, allowed bool)
+}
+
+type TrustedDocumentStats struct {
+	// ID is the id of the trusted document used, empty for untrusted operations
+	ID string
+
+	// Trusted is true if the operation was found in the manifest
+	Trusted bool
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = TrustedDocuments{}
+
+func (t TrustedDocuments) ExtensionName() string {
+	return trustedDocumentsExtension
+}
+
+func (t TrustedDocuments) Validate(schema graphql.ExecutableSchema) error {
+	if t.Manifest == nil {
+		return errors.New("TrustedDocuments.Manifest can not be nil")
+	}
+	if t.Mode == TrustedDocumentsAllowWithHeader && (t.BypassHeader == "" || t.BypassToken == "") {
+		return errors.New("TrustedDocuments.BypassHeader and BypassToken are required " +
+			"when using TrustedDocumentsAllowWithHeader")
+	}
+	return nil
+}
+
+func (t TrustedDocuments) MutateOperationParameters(
+	ctx context.Context,
+	rawParams *graphql.RawParams,
+) *gqlerror.Error {
+	var extension struct {
+		Sha256  string `mapstructure:"sha256Hash"`
+		Version int64  `mapstructure:"version"`
+	}
+
+	if rawParams.Extensions["persistedQuery"] != nil {
+		if err := mapstructure.Decode(rawParams.Extensions["persistedQuery"], &extension); err != nil {
+			return gqlerror.Errorf("invalid persisted query extension data")
+		}
+	}
+
+	if extension.Sha256 != "" {
+		query, ok := t.Manifest.Get(extension.Sha256)
+		if ok {
+			rawParams.Query = query
+			graphql.GetOperationContext(ctx).Stats.SetExtension(
+				trustedDocumentsExtension,
+				&TrustedDocumentStats{ID: extension.Sha256, Trusted: true},
+			)
+			return nil
+		}
+		// APQ clients send the hash of an unknown document along with its query, which is then
+		// handled like an ad-hoc query according to Mode.
+		if rawParams.Query == "" {
+			err := gqlerror.Errorf(errPersistedQueryNotFound)
+			errcode.Set(err, errPersistedQueryNotFoundCode)
+			return err
+		}
+	}
+
+	// The client sent an ad-hoc query. It is still trusted if it matches a document in the
+	// manifest byte for byte.
+	if id, ok := t.Manifest.lookupQuery(rawParams.Query); ok {
+		graphql.GetOperationContext(ctx).Stats.SetExtension(
+			trustedDocumentsExtension,
+			&TrustedDocumentStats{ID: id, Trusted: true},
+		)
+		return nil
+	}
+
+	allowed := t.allowUntrusted(rawParams)
+	if t.OnUntrusted != nil {
+		t.OnUntrusted(ctx, rawParams, allowed)
+	}
+	if !allowed {
+		err := gqlerror.Errorf("operation is not in the list of trusted documents")
+		if rawParams.Query == "" {
+			errcode.Set(err, errPersistedQueryIDRequired)
+		} else {
+			errcode.Set(err, errPersistedQueryNotInList)
+		}
+		return err
+	}
+
+	graphql.GetOperationContext(ctx).Stats.SetExtension(
+		trustedDocumentsExtension,
+		&TrustedDocumentStats{},
+	)
+	return nil
+}
+
+func (t TrustedDocuments) allowUntrusted(rawParams *graphql.RawParams) bool {
+	switch t.Mode {
+	case TrustedDocumentsAudit:
+		return true
+	case TrustedDocumentsAllowWithHeader:
+		token := rawParams.Headers.Get(t.BypassHeader)
+		return subtle.ConstantTimeCompare([]byte(token), []byte(t.BypassToken)) == 1
+	default:
+		return false
+	}
+}
+
+func GetTrustedDocumentStats(ctx context.Context) *TrustedDocumentStats {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil {
+		return nil
+	}
+
+	s, _ := opCtx.Stats.GetExtension(trustedDocumentsExtension).(*TrustedDocumentStats)
+	return s
+}
+
+// TrustedDocumentManifest is a set of trusted documents keyed by id. It is safe for concurrent
+// use, and can be replaced while the server is running with Set, ReloadFile or WatchFile.
+type TrustedDocumentManifest struct {
+	mu      sync.RWMutex
+	docs    map[string]string
+	queries map[string]string
+}
+
+// NewTrustedDocumentManifest returns a manifest containing docs, a map of document ids to query
+// documents.
+func NewTrustedDocumentManifest(docs map[string]string) *TrustedDocumentManifest {
+	m := &TrustedDocumentManifest{}
+	m.Set(docs)
+	return m
+}
+
+// LoadTrustedDocumentManifest reads a manifest from the file at path. See
+// ParseTrustedDocumentManifest for the supported formats.
+func LoadTrustedDocumentManifest(path string) (*TrustedDocumentManifest, error) {
+	m := &TrustedDocumentManifest{}
+	if err := m.ReloadFile(path); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Get returns the query document with the given id.
+func (m *TrustedDocumentManifest) Get(id string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	query, ok := m.docs[id]
+	return query, ok
+}
+
+// Set atomically replaces every document in the manifest. The manifest keeps a copy of docs, so
+// the caller may keep modifying it.
+func (m *TrustedDocumentManifest) Set(docs map[string]string) {
+	docs = maps.Clone(docs)
+	queries := make(map[string]string, len(docs))
+	for id, query := range docs {
+		queries[query] = id
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.docs = docs
+	m.queries = queries
+}
+
+func (m *TrustedDocumentManifest) lookupQuery(query string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	id, ok := m.queries[query]
+	return id, ok
+}
+
+// ReloadFile replaces the documents in the manifest with the contents of the file at path. The
+// manifest is left unchanged if the file can not be read or parsed.
+func (m *TrustedDocumentManifest) ReloadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read trusted document manifest: %w", err)
+	}
+	docs, err := ParseTrustedDocumentManifest(data)
+	if err != nil {
+		return fmt.Errorf("unable to parse trusted document manifest %s: %w", path, err)
+	}
+	m.Set(docs)
+	return nil
+}
+
+// WatchFile starts a goroutine that checks the file at path for changes every interval, and
+// reloads the manifest when its modification time changes. A zero interval checks every ten
+// seconds. Failed reloads are reported to onError, if set, and leave the current documents in
+// place. The goroutine stops when ctx is cancelled.
+func (m *TrustedDocumentManifest) WatchFile(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	onError func(error),
+) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	var modTime time.Time
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime()
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			info, err := os.Stat(path)
+			if err == nil && info.ModTime().Equal(modTime) {
+				continue
+			}
+			if err == nil {
+				modTime = info.ModTime()
+				err = m.ReloadFile(path)
+			}
+			if err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}()
+}
+
+// ParseTrustedDocumentManifest parses a manifest of trusted documents, returning the documents
+// keyed by id. Two formats are supported:
+//
+//   - the Apollo persisted query manifest, an object with a format of
+//     "apollo-persisted-query-manifest" and a list of operations with an id and a body.
+//   - the Relay persisted query format, a flat object mapping ids to query documents.
+func ParseTrustedDocumentManifest(data []byte) (map[string]string, error) {
+	var apollo struct {
+		Format     string `json:"format"`
+		Version    int    `json:"version"`
+		Operations []struct {
+			ID   string `json:"id"`
+			Body string `json:"body"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(data, &apollo); err == nil && apollo.Format != "" {
+		if apollo.Format != apolloPersistedQueryManifest {
+			return nil, fmt.Errorf("unsupported manifest format %q", apollo.Format)
+		}
+		if apollo.Version != 1 {
+			return nil, fmt.Errorf("unsupported manifest version %d", apollo.Version)
+		}
+
+		docs := make(map[string]string, len(apollo.Operations))
+		for _, op := range apollo.Operations {
+			if op.ID == "" {
+				return nil, errors.New("manifest operation is missing an id")
+			}
+			docs[op.ID] = op.Body
+		}
+		return docs, nil
+	}
+
+	var relay map[string]string
+	if err := json.Unmarshal(data, &relay); err != nil {
+		return nil, errors.New("manifest is neither an Apollo nor a Relay persisted query manifest")
+	}
+	return relay, nil
+}
@@ -0,0 +1,246 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestTrustedDocuments(t *testing.T) {
+	manifest := extension.NewTrustedDocumentManifest(map[string]string{
+		"abc123": "{ name }",
+	})
+
+	newServer := func(td extension.TrustedDocuments) *testserver.TestServer {
+		td.Manifest = manifest
+		h := testserver.New()
+		h.Use(td)
+		h.AddTransport(&transport.POST{})
+		return h
+	}
+
+	t.Run("trusted document by id", func(t *testing.T) {
+		h := newServer(extension.TrustedDocuments{})
+		var stats *extension.TrustedDocumentStats
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			stats = extension.GetTrustedDocumentStats(ctx)
+			return next(ctx)
+		})
+
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"abc123"}}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, &extension.TrustedDocumentStats{ID: "abc123", Trusted: true}, stats)
+	})
+
+	t.Run("unknown id", func(t *testing.T) {
+		h := newServer(extension.TrustedDocuments{Mode: extension.TrustedDocumentsAudit})
+
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"unknown"}}}`)
+		require.JSONEq(t,
+			`{"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}],"data":null}`,
+			resp.Body.String())
+	})
+
+	t.Run("unknown id with a query", func(t *testing.T) {
+		const body = `{"query":"{ other: name }",` +
+			`"extensions":{"persistedQuery":{"version":1,"sha256Hash":"unknown"}}}`
+
+		tests := []struct {
+			name    string
+			td      extension.TrustedDocuments
+			headers map[string]string
+			want    string
+		}{
+			{
+				name: "enforce",
+				want: `{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"PERSISTED_QUERY_NOT_IN_LIST"}}],"data":null}`,
+			},
+			{
+				name: "audit",
+				td:   extension.TrustedDocuments{Mode: extension.TrustedDocumentsAudit},
+				want: `{"data":{"name":"test"}}`,
+			},
+			{
+				name: "allow with header without the header",
+				td: extension.TrustedDocuments{
+					Mode:         extension.TrustedDocumentsAllowWithHeader,
+					BypassHeader: "X-Allow-Untrusted",
+					BypassToken:  "secret",
+				},
+				want: `{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"PERSISTED_QUERY_NOT_IN_LIST"}}],"data":null}`,
+			},
+			{
+				name: "allow with header",
+				td: extension.TrustedDocuments{
+					Mode:         extension.TrustedDocumentsAllowWithHeader,
+					BypassHeader: "X-Allow-Untrusted",
+					BypassToken:  "secret",
+				},
+				headers: map[string]string{"X-Allow-Untrusted": "secret"},
+				want:    `{"data":{"name":"test"}}`,
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				var reported []string
+				tc.td.OnUntrusted = func(
+					ctx context.Context,
+					params *graphql.RawParams,
+					allowed bool,
+				) {
+					reported = append(reported, params.Query)
+				}
+				h := newServer(tc.td)
+
+				r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+				r.Header.Set("Content-Type", "application/json")
+				for k, v := range tc.headers {
+					r.Header.Set(k, v)
+				}
+				resp := httptest.NewRecorder()
+				h.ServeHTTP(resp, r)
+				require.JSONEq(t, tc.want, resp.Body.String())
+				require.Equal(t, []string{"{ other: name }"}, reported)
+			})
+		}
+	})
+
+	t.Run("ad-hoc query matching a trusted document", func(t *testing.T) {
+		h := newServer(extension.TrustedDocuments{})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("enforce rejects ad-hoc queries", func(t *testing.T) {
+		h := newServer(extension.TrustedDocuments{})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ other: name }"}`)
+		require.JSONEq(t,
+			`{"errors":[{"message":"operation is not in the list of trusted documents","extensions":{"code":"PERSISTED_QUERY_NOT_IN_LIST"}}],"data":null}`,
+			resp.Body.String())
+	})
+
+	t.Run("audit allows and reports ad-hoc queries", func(t *testing.T) {
+		var reported []string
+		h := newServer(extension.TrustedDocuments{
+			Mode: extension.TrustedDocumentsAudit,
+			OnUntrusted: func(ctx context.Context, params *graphql.RawParams, allowed bool) {
+				assert.True(t, allowed)
+				reported = append(reported, params.Query)
+			},
+		})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ other: name }"}`)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, []string{"{ other: name }"}, reported)
+	})
+
+	t.Run("allow with header", func(t *testing.T) {
+		h := newServer(extension.TrustedDocuments{
+			Mode:         extension.TrustedDocumentsAllowWithHeader,
+			BypassHeader: "X-Allow-Untrusted",
+			BypassToken:  "secret",
+		})
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ other: name }"}`)
+		require.Contains(t, resp.Body.String(), "PERSISTED_QUERY_NOT_IN_LIST")
+
+		r := httptest.NewRequest(http.MethodPost, "/graphql",
+			strings.NewReader(`{"query":"{ other: name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Allow-Untrusted", "secret")
+		resp = httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		require.Error(t, extension.TrustedDocuments{}.Validate(nil))
+		require.Error(t, extension.TrustedDocuments{
+			Manifest: manifest,
+			Mode:     extension.TrustedDocumentsAllowWithHeader,
+		}.Validate(nil))
+	})
+}
+
+func TestParseTrustedDocumentManifest(t *testing.T) {
+	t.Run("apollo", func(t *testing.T) {
+		docs, err := extension.ParseTrustedDocumentManifest([]byte(`{
+			"format": "apollo-persisted-query-manifest",
+			"version": 1,
+			"operations": [
+				{"id": "abc", "name": "Name", "type": "query", "body": "query Name { name }"}
+			]
+		}`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"abc": "query Name { name }"}, docs)
+	})
+
+	t.Run("relay", func(t *testing.T) {
+		docs, err := extension.ParseTrustedDocumentManifest([]byte(`{"abc": "{ name }"}`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"abc": "{ name }"}, docs)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := extension.ParseTrustedDocumentManifest([]byte(`{"format": "other"}`))
+		require.EqualError(t, err, `unsupported manifest format "other"`)
+
+		_, err = extension.ParseTrustedDocumentManifest([]byte(`[]`))
+		require.Error(t, err)
+	})
+}
+
+func TestTrustedDocumentManifestReloadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"a": "{ name }"}`), 0o600))
+
+	manifest, err := extension.LoadTrustedDocumentManifest(path)
+	require.NoError(t, err)
+	query, ok := manifest.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "{ name }", query)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"b": "{ find(id: 1) }"}`), 0o600))
+	require.NoError(t, manifest.ReloadFile(path))
+	_, ok = manifest.Get("a")
+	require.False(t, ok)
+	_, ok = manifest.Get("b")
+	require.True(t, ok)
+
+	require.NoError(t, os.WriteFile(path, []byte(`not json`), 0o600))
+	require.Error(t, manifest.ReloadFile(path))
+	_, ok = manifest.Get("b")
+	require.True(t, ok, "a failed reload keeps the previous documents")
+}
+
+func TestTrustedDocumentManifestCopiesDocuments(t *testing.T) {
+	docs := map[string]string{"a": "{ name }"}
+	manifest := extension.NewTrustedDocumentManifest(docs)
+
+	docs["a"] = "{ find(id: 1) }"
+	docs["b"] = "{ name }"
+
+	query, ok := manifest.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "{ name }", query)
+	_, ok = manifest.Get("b")
+	require.False(t, ok)
+}
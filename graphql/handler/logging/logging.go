@@ -0,0 +1,263 @@
+package logging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+// Redacted replaces the values of redacted variables and arguments in log records.
+const Redacted = graphql.Redacted
+
+type ctxKey string
+
+const operationCtx ctxKey = "logging_operation"
+
+// Logger is a HandlerExtension that writes a structured log record through log/slog for every
+// graphql operation, with its name, type, query hash, timings, complexity and error codes:
+//
+//	srv.Use(&logging.Logger{
+//		SlowOperation:   time.Second,
+//		SlowField:       100 * time.Millisecond,
+//		RedactVariables: regexp.MustCompile(`(?i)password|token|secret`),
+//	})
+//
+// Operations taking longer than SlowOperation, and operations returning errors, are logged at
+// slog.LevelWarn. Resolvers taking longer than SlowField get a record of their own with the
+// field path and arguments. Complexity is only logged when the ComplexityLimit extension is
+// also in use.
+//
+// Variables and arguments are logged after redaction: values whose name matches
+// RedactVariables, or which are passed to arguments or input fields annotated with
+// RedactDirective (@sensitive by default), are replaced with Redacted.
+type Logger struct {
+	// Logger receives the log records. Defaults to slog.Default().
+	Logger *slog.Logger
+
+	// Level is the level of operations that are neither slow nor failed. Defaults to
+	// slog.LevelInfo.
+	Level slog.Level
+
+	// SlowOperation is the duration above which operations are logged as slow. Zero disables
+	// slow operation detection.
+	SlowOperation time.Duration
+
+	// SlowField is the duration above which resolvers are logged as slow. Zero disables slow
+	// resolver detection.
+	SlowField time.Duration
+
+	// RedactVariables matches the names of variables, arguments and input fields whose values
+	// are redacted.
+	RedactVariables *regexp.Regexp
+
+	// RedactDirective is the name of the directive marking arguments and input fields whose
+	// values are redacted. Defaults to graphql.SensitiveDirective.
+	RedactDirective string
+
+	// OmitVariables leaves variables out of operation records entirely.
+	OmitVariables bool
+
+	logger      *slog.Logger
+	sensitivity *graphql.Sensitivity
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = &Logger{}
+
+func (l *Logger) ExtensionName() string {
+	return "Logger"
+}
+
+func (l *Logger) Validate(schema graphql.ExecutableSchema) error {
+	l.logger = l.Logger
+	if l.logger == nil {
+		l.logger = slog.Default()
+	}
+	directive := l.RedactDirective
+	if directive == "" {
+		directive = graphql.SensitiveDirective
+	}
+	l.sensitivity = graphql.NewSensitivity(schema.Schema(), directive)
+	return nil
+}
+
+func (l *Logger) InterceptOperation(
+	ctx context.Context,
+	next graphql.OperationHandler,
+) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	complexity := extension.GetComplexityStats(ctx)
+
+	responses := next(context.WithValue(ctx, operationCtx, true))
+	isSubscription := opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription
+	var errs gqlerror.List
+	done := false
+
+	// A query or mutation produces a single response, or several when @defer is used. A
+	// subscription is logged once the stream is closed.
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if done {
+			return resp
+		}
+		if resp != nil {
+			errs = append(errs, resp.Errors...)
+			if isSubscription || resp.HasNext != nil && *resp.HasNext {
+				return resp
+			}
+		}
+
+		done = true
+		l.logOperation(ctx, opCtx, complexity, errs)
+		return resp
+	}
+}
+
+// InterceptResponse logs operations rejected before execution, for example because they failed
+// to parse or validate. Those never reach InterceptOperation.
+func (l *Logger) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if ctx.Value(operationCtx) != nil || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	resp := next(ctx)
+	var errs gqlerror.List
+	if resp != nil {
+		errs = resp.Errors
+	}
+	l.logOperation(ctx, graphql.GetOperationContext(ctx), extension.GetComplexityStats(ctx), errs)
+	return resp
+}
+
+func (l *Logger) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	if l.SlowField <= 0 {
+		return next(ctx)
+	}
+
+	start := graphql.Now()
+	res, err := next(ctx)
+	duration := graphql.Now().Sub(start)
+	if duration < l.SlowField {
+		return res, err
+	}
+
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return res, err
+	}
+	attrs := []slog.Attr{
+		slog.String("path", fc.Path().String()),
+		slog.String("coordinate", fc.Object+"."+fc.Field.Name),
+		slog.Duration("duration", duration),
+	}
+	if graphql.HasOperationContext(ctx) {
+		opCtx := graphql.GetOperationContext(ctx)
+		attrs = append(attrs,
+			slog.String("operation_name", opCtx.OperationName),
+			slog.Any("arguments", l.arguments(fc.Field.Field, opCtx.Variables)),
+		)
+	}
+	l.logger.LogAttrs(ctx, slog.LevelWarn, "slow graphql resolver", attrs...)
+
+	return res, err
+}
+
+func (l *Logger) logOperation(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+	complexity *extension.ComplexityStats,
+	errs gqlerror.List,
+) {
+	end := graphql.Now()
+	duration := end.Sub(opCtx.Stats.OperationStart)
+	if opCtx.Stats.OperationStart.IsZero() {
+		duration = 0
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation_name", opCtx.OperationName),
+		slog.String("operation_type", operationType(opCtx)),
+		slog.Duration("duration", duration),
+	}
+	if opCtx.RawQuery != "" {
+		attrs = append(attrs, slog.String("query_hash", queryHash(opCtx.RawQuery)))
+	}
+
+	timings := []any{
+		timing("read", opCtx.Stats.Read),
+		timing("parse", opCtx.Stats.Parsing),
+		timing("validate", opCtx.Stats.Validation),
+	}
+	if !opCtx.Stats.Validation.End.IsZero() && opCtx.Operation != nil {
+		timings = append(timings, slog.Duration("execute", end.Sub(opCtx.Stats.Validation.End)))
+	}
+	attrs = append(attrs, slog.Group("timing", timings...))
+
+	if complexity != nil {
+		attrs = append(attrs, slog.Int("complexity", complexity.Complexity))
+	}
+	if !l.OmitVariables && len(opCtx.Variables) > 0 {
+		attrs = append(attrs, slog.Any("variables", l.variables(opCtx)))
+	}
+
+	level, msg := l.Level, "graphql operation"
+	if len(errs) > 0 {
+		level = max(level, slog.LevelWarn)
+		attrs = append(attrs,
+			slog.Int("errors", len(errs)),
+			slog.Any("error_codes", errorCodes(errs)),
+		)
+	}
+	if l.SlowOperation > 0 && duration >= l.SlowOperation {
+		level, msg = max(level, slog.LevelWarn), "slow graphql operation"
+		attrs = append(attrs, slog.Bool("slow", true))
+	}
+
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+func timing(name string, timing graphql.TraceTiming) slog.Attr {
+	if timing.Start.IsZero() || timing.End.IsZero() {
+		return slog.Duration(name, 0)
+	}
+	return slog.Duration(name, timing.End.Sub(timing.Start))
+}
+
+func errorCodes(errs gqlerror.List) []string {
+	var codes []string
+	for _, err := range errs {
+		if code, ok := err.Extensions["code"].(string); ok && !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+func operationType(opCtx *graphql.OperationContext) string {
+	if opCtx.Operation == nil {
+		return ""
+	}
+	return string(opCtx.Operation.Operation)
+}
+
+func queryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(hash[:])
+}
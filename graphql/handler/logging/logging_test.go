@@ -0,0 +1,190 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/logging"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestLogger(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		defer func() {
+			now = now.Add(time.Millisecond)
+		}()
+		return now
+	}
+	defer func() { graphql.Now = time.Now }()
+
+	var buf bytes.Buffer
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(extension.FixedComplexityLimit(100))
+	h.Use(&logging.Logger{
+		Logger:        slog.New(slog.NewJSONHandler(&buf, nil)),
+		SlowOperation: 5 * time.Millisecond,
+		SlowField:     time.Millisecond,
+	})
+
+	t.Run("logs operations", func(t *testing.T) {
+		buf.Reset()
+		h.SetCalculatedComplexity(3)
+		resp := doRequest(h, `{"query":"query Named { name }","operationName":"Named"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		records := readRecords(t, &buf)
+		require.Len(t, records, 2)
+
+		require.Equal(t, "slow graphql resolver", records[0]["msg"])
+		require.Equal(t, "WARN", records[0]["level"])
+		require.Equal(t, "name", records[0]["path"])
+		require.Equal(t, "Query.name", records[0]["coordinate"])
+		require.Equal(t, "Named", records[0]["operation_name"])
+
+		require.Equal(t, "slow graphql operation", records[1]["msg"])
+		require.Equal(t, "WARN", records[1]["level"])
+		require.Equal(t, "Named", records[1]["operation_name"])
+		require.Equal(t, "query", records[1]["operation_type"])
+		require.Len(t, records[1]["query_hash"], 64)
+		require.InDelta(t, 3, records[1]["complexity"], 0)
+		require.Equal(t, true, records[1]["slow"])
+		require.Contains(t, records[1]["timing"], "parse")
+		require.Contains(t, records[1]["timing"], "execute")
+	})
+
+	t.Run("logs rejected operations", func(t *testing.T) {
+		buf.Reset()
+		resp := doRequest(h, `{"query":"{ unknown }"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+		records := readRecords(t, &buf)
+		require.Len(t, records, 1)
+		require.Equal(t, "WARN", records[0]["level"])
+		require.InDelta(t, 1, records[0]["errors"], 0)
+		require.Equal(t, []any{"GRAPHQL_VALIDATION_FAILED"}, records[0]["error_codes"])
+	})
+}
+
+func TestLoggerRedaction(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+		type Query {
+			login(input: LoginInput!, token: String @sensitive, apiKey: String): String
+		}
+		input LoginInput {
+			user: String!
+			password: String! @sensitive
+			devices: [Device!]
+		}
+		input Device {
+			name: String!
+			secret: String! @sensitive
+		}
+	`})
+
+	var buf bytes.Buffer
+	h := handler.New(&graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"login":null}`)})
+		},
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+	})
+	h.AddTransport(transport.POST{})
+	h.Use(&logging.Logger{
+		Logger:          slog.New(slog.NewJSONHandler(&buf, nil)),
+		RedactVariables: regexp.MustCompile(`(?i)key`),
+		RedactDirective: "sensitive",
+	})
+
+	tests := []struct {
+		name      string
+		query     string
+		variables string
+		expected  map[string]any
+	}{
+		{
+			name:  "sensitive input fields",
+			query: `query($in: LoginInput!) { login(input: $in) }`,
+			variables: `{"in":{"user":"bob","password":"hunter2",` +
+				`"devices":[{"name":"phone","secret":"abc"}]}}`,
+			expected: map[string]any{"in": map[string]any{
+				"user":     "bob",
+				"password": logging.Redacted,
+				"devices":  []any{map[string]any{"name": "phone", "secret": logging.Redacted}},
+			}},
+		},
+		{
+			name: "sensitive arguments and names",
+			query: `query($in: LoginInput!, $token: String, $k: String) ` +
+				`{ login(input: $in, token: $token, apiKey: $k) }`,
+			variables: `{"in":{"user":"bob","password":"hunter2"},"token":"t","k":"k"}`,
+			expected: map[string]any{
+				"in":    map[string]any{"user": "bob", "password": logging.Redacted},
+				"token": logging.Redacted,
+				"k":     "k",
+			},
+		},
+		{
+			name: "sensitive input fields in literals",
+			query: `query($pw: String!, $apiKey: String) ` +
+				`{ login(input: {user: "bob", password: $pw}, apiKey: $apiKey) }`,
+			variables: `{"pw":"hunter2","apiKey":"k"}`,
+			expected:  map[string]any{"pw": logging.Redacted, "apiKey": logging.Redacted},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf.Reset()
+			query, err := json.Marshal(tc.query)
+			require.NoError(t, err)
+			resp := doRequest(h, `{"query":`+string(query)+`,"variables":`+tc.variables+`}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+			records := readRecords(t, &buf)
+			require.Len(t, records, 1)
+			require.Equal(t, tc.expected, records[0]["variables"])
+		})
+	}
+}
+
+func readRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func doRequest(handler http.Handler, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
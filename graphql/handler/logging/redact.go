@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// variables returns the variables of the operation with sensitive values redacted.
+func (l *Logger) variables(opCtx *graphql.OperationContext) map[string]any {
+	return l.redactNames(l.sensitivity.RedactVariables(opCtx))
+}
+
+// arguments returns the arguments of field with sensitive values redacted.
+func (l *Logger) arguments(field *ast.Field, vars map[string]any) map[string]any {
+	return l.redactNames(l.sensitivity.RedactArguments(field, vars))
+}
+
+// redactNames returns a copy of values with the values whose name matches RedactVariables
+// redacted, at any depth.
+func (l *Logger) redactNames(values map[string]any) map[string]any {
+	if l.RedactVariables == nil {
+		return values
+	}
+
+	redacted := make(map[string]any, len(values))
+	for name, value := range values {
+		if l.RedactVariables.MatchString(name) {
+			redacted[name] = Redacted
+			continue
+		}
+		redacted[name] = l.redactNestedNames(value)
+	}
+	return redacted
+}
+
+func (l *Logger) redactNestedNames(value any) any {
+	switch value := value.(type) {
+	case []any:
+		redacted := make([]any, len(value))
+		for i, v := range value {
+			redacted[i] = l.redactNestedNames(v)
+		}
+		return redacted
+	case map[string]any:
+		return l.redactNames(value)
+	default:
+		return value
+	}
+}
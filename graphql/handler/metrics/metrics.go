@@ -0,0 +1,311 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+// DefaultBuckets returns the latency histogram buckets, in seconds, used when Metrics.Buckets is
+// empty.
+func DefaultBuckets() []float64 {
+	return []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+}
+
+// DefaultComplexityBuckets returns the complexity histogram buckets used when
+// Metrics.ComplexityBuckets is empty.
+func DefaultComplexityBuckets() []float64 {
+	return []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+}
+
+type ctxKey string
+
+const operationCtx ctxKey = "metrics_operation"
+
+// Metrics is a HandlerExtension that aggregates per request statistics across requests and
+// serves them in the Prometheus text exposition format. Mount it as an http.Handler on your
+// metrics endpoint:
+//
+//	m := &metrics.Metrics{}
+//	srv.Use(m)
+//	http.Handle("/metrics", m)
+//
+// Complexity and APQ metrics are only populated when the ComplexityLimit and
+// AutomaticPersistedQuery extensions are also in use.
+type Metrics struct {
+	// Namespace prefixes every metric name. Defaults to "gqlgen".
+	Namespace string
+
+	// Buckets are the upper bounds, in seconds, of the latency histograms, in increasing order.
+	// Defaults to DefaultBuckets.
+	Buckets []float64
+
+	// ComplexityBuckets are the upper bounds of the operation complexity histogram, in
+	// increasing order. Defaults to DefaultComplexityBuckets.
+	ComplexityBuckets []float64
+
+	// FieldCoordinates lists the schema coordinates, like "Query.products", whose resolver latency
+	// is recorded. Recording every field would create a series per field in the schema, so field
+	// latency is opt-in.
+	FieldCoordinates []string
+
+	// OperationNames lists the operation names recorded in the operation_name label. Clients
+	// choose operation names, so recording any of them would let clients create as many series
+	// as they like: the other named operations are recorded as "other", and anonymous ones as "".
+	OperationNames []string
+
+	fields         map[string]bool
+	operationNames map[string]bool
+	operations     *counter
+	errors         *counter
+	apq            *counter
+	duration       *histogram
+	phases         *histogram
+	complexity     *histogram
+	fieldTiming    *histogram
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+	http.Handler
+} = &Metrics{}
+
+func (m *Metrics) ExtensionName() string {
+	return "Metrics"
+}
+
+func (m *Metrics) Validate(schema graphql.ExecutableSchema) error {
+	m.fields = map[string]bool{}
+	for _, coordinate := range m.FieldCoordinates {
+		typeName, fieldName, ok := strings.Cut(coordinate, ".")
+		if !ok {
+			return fmt.Errorf("invalid field coordinate %q, expected Type.field", coordinate)
+		}
+		def := schema.Schema().Types[typeName]
+		if def == nil || def.Fields.ForName(fieldName) == nil {
+			return fmt.Errorf("field coordinate %q does not exist in the schema", coordinate)
+		}
+		m.fields[coordinate] = true
+	}
+	m.operationNames = map[string]bool{}
+	for _, name := range m.OperationNames {
+		m.operationNames[name] = true
+	}
+
+	ns := m.Namespace
+	if ns == "" {
+		ns = "gqlgen"
+	}
+	buckets, err := histogramBuckets("Buckets", m.Buckets, DefaultBuckets)
+	if err != nil {
+		return err
+	}
+	complexityBuckets, err := histogramBuckets(
+		"ComplexityBuckets", m.ComplexityBuckets, DefaultComplexityBuckets)
+	if err != nil {
+		return err
+	}
+
+	m.operations = newCounter(ns+"_operations_total",
+		"Number of graphql operations received.",
+		"operation_type", "operation_name")
+	m.errors = newCounter(ns+"_errors_total",
+		"Number of graphql errors returned, by extensions.code.",
+		"code")
+	m.apq = newCounter(ns+"_apq_requests_total",
+		"Number of automatic persisted query lookups, by result.",
+		"result")
+	m.duration = newHistogram(ns+"_operation_duration_seconds",
+		"Time from receiving an operation to sending its last response.",
+		buckets, "operation_type")
+	m.phases = newHistogram(ns+"_phase_duration_seconds",
+		"Time spent reading, parsing, validating and executing operations.",
+		buckets, "operation_type", "phase")
+	m.complexity = newHistogram(ns+"_operation_complexity",
+		"Calculated complexity of operations.",
+		complexityBuckets, "operation_type")
+	m.fieldTiming = newHistogram(ns+"_field_duration_seconds",
+		"Time spent in the resolvers of opted-in fields.",
+		buckets, "coordinate")
+
+	return nil
+}
+
+func (m *Metrics) InterceptOperation(
+	ctx context.Context,
+	next graphql.OperationHandler,
+) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	m.recordOperation(ctx, opCtx)
+
+	responses := next(context.WithValue(ctx, operationCtx, true))
+	done := false
+
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if done {
+			return resp
+		}
+		if resp != nil {
+			m.recordErrors(resp.Errors)
+			if resp.HasNext != nil && *resp.HasNext || isSubscription(opCtx) {
+				return resp
+			}
+		}
+
+		// the operation has sent its last response
+		done = true
+		end := graphql.Now()
+		opType := operationType(opCtx)
+		executionStart := opCtx.Stats.Validation.End
+		if executionStart.IsZero() {
+			executionStart = opCtx.Stats.OperationStart
+		}
+		m.phases.observe(end.Sub(executionStart).Seconds(), opType, "execute")
+		m.duration.observe(end.Sub(opCtx.Stats.OperationStart).Seconds(), opType)
+
+		return resp
+	}
+}
+
+// InterceptResponse records operations rejected before execution, for example because they
+// failed to parse or validate. Those never reach InterceptOperation.
+func (m *Metrics) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if ctx.Value(operationCtx) != nil || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	m.recordOperation(ctx, opCtx)
+
+	resp := next(ctx)
+	if resp != nil {
+		m.recordErrors(resp.Errors)
+	}
+	if !opCtx.Stats.OperationStart.IsZero() {
+		m.duration.observe(
+			graphql.Now().Sub(opCtx.Stats.OperationStart).Seconds(),
+			operationType(opCtx),
+		)
+	}
+
+	return resp
+}
+
+func (m *Metrics) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !m.fields[fc.Object+"."+fc.Field.Name] {
+		return next(ctx)
+	}
+
+	start := graphql.Now()
+	defer func() {
+		m.fieldTiming.observe(graphql.Now().Sub(start).Seconds(), fc.Object+"."+fc.Field.Name)
+	}()
+
+	return next(ctx)
+}
+
+// ServeHTTP writes every metric collected so far in the Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if m.operations == nil {
+		http.Error(w, "metrics extension has not been added to a server",
+			http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.operations.write(w)
+	m.errors.write(w)
+	m.apq.write(w)
+	m.duration.write(w)
+	m.phases.write(w)
+	m.complexity.write(w)
+	m.fieldTiming.write(w)
+}
+
+// recordOperation records the statistics available once the operation context has been built.
+func (m *Metrics) recordOperation(ctx context.Context, opCtx *graphql.OperationContext) {
+	opType := operationType(opCtx)
+	m.operations.add(1, opType, m.operationName(opCtx))
+
+	m.observeTiming(opType, "read", opCtx.Stats.Read)
+	m.observeTiming(opType, "parse", opCtx.Stats.Parsing)
+	m.observeTiming(opType, "validate", opCtx.Stats.Validation)
+
+	if stats := extension.GetComplexityStats(ctx); stats != nil {
+		m.complexity.observe(float64(stats.Complexity), opType)
+	}
+	if stats := extension.GetApqStats(ctx); stats != nil {
+		if stats.SentQuery {
+			m.apq.add(1, "miss")
+		} else {
+			m.apq.add(1, "hit")
+		}
+	}
+}
+
+// operationName returns the operation_name label of opCtx, keeping the number of series bounded.
+func (m *Metrics) operationName(opCtx *graphql.OperationContext) string {
+	if opCtx.OperationName == "" || m.operationNames[opCtx.OperationName] {
+		return opCtx.OperationName
+	}
+	return "other"
+}
+
+func (m *Metrics) observeTiming(opType, phase string, timing graphql.TraceTiming) {
+	if timing.Start.IsZero() || timing.End.IsZero() {
+		return
+	}
+	m.phases.observe(timing.End.Sub(timing.Start).Seconds(), opType, phase)
+}
+
+func (m *Metrics) recordErrors(errs gqlerror.List) {
+	for _, err := range errs {
+		code, _ := err.Extensions["code"].(string)
+		m.errors.add(1, code)
+	}
+}
+
+// histogramBuckets returns a copy of the configured buckets, or the defaults when none are
+// configured, so that later changes to the Metrics fields cannot corrupt the histograms.
+func histogramBuckets(field string, configured []float64, defaults func() []float64) (
+	[]float64,
+	error,
+) {
+	if len(configured) == 0 {
+		return defaults(), nil
+	}
+	for i := 1; i < len(configured); i++ {
+		if configured[i] <= configured[i-1] {
+			return nil, fmt.Errorf("%s must be in strictly increasing order, got %v",
+				field, configured)
+		}
+	}
+	return slices.Clone(configured), nil
+}
+
+func operationType(opCtx *graphql.OperationContext) string {
+	if opCtx.Operation == nil {
+		return ""
+	}
+	return string(opCtx.Operation.Operation)
+}
+
+func isSubscription(opCtx *graphql.OperationContext) bool {
+	return opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription
+}
@@ -0,0 +1,129 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/metrics"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestMetrics(t *testing.T) {
+	now := time.Unix(0, 0)
+	graphql.Now = func() time.Time {
+		defer func() {
+			now = now.Add(time.Millisecond)
+		}()
+		return now
+	}
+	defer func() { graphql.Now = time.Now }()
+
+	m := &metrics.Metrics{
+		Buckets:          []float64{0.001, 0.01},
+		FieldCoordinates: []string{"Query.name"},
+		OperationNames:   []string{"Named"},
+	}
+
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(extension.FixedComplexityLimit(100))
+	h.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+	h.Use(m)
+
+	resp := doRequest(h, `{"query":"query Named { name }","operationName":"Named"}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	resp = doRequest(h, `{"query":"{ unknown }"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+	resp = doRequest(h, `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"unknown"}}}`)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	for _, line := range []string{
+		`# TYPE gqlgen_operations_total counter`,
+		`gqlgen_operations_total{operation_type="query",operation_name="Named"} 1`,
+		`gqlgen_operations_total{operation_type="",operation_name=""} 2`,
+		`gqlgen_errors_total{code="GRAPHQL_VALIDATION_FAILED"} 1`,
+		`gqlgen_errors_total{code="PERSISTED_QUERY_NOT_FOUND"} 1`,
+		`# TYPE gqlgen_phase_duration_seconds histogram`,
+		`gqlgen_phase_duration_seconds_bucket{operation_type="query",phase="parse",le="0.001"} 1`,
+		`gqlgen_phase_duration_seconds_count{operation_type="query",phase="execute"} 1`,
+		`gqlgen_operation_complexity_bucket{operation_type="query",le="+Inf"} 1`,
+		`gqlgen_field_duration_seconds_bucket{coordinate="Query.name",le="0.001"} 1`,
+		`gqlgen_field_duration_seconds_sum{coordinate="Query.name"} 0.001`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+}
+
+func TestMetricsOperationNames(t *testing.T) {
+	m := &metrics.Metrics{OperationNames: []string{"Named"}}
+
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(m)
+
+	for _, name := range []string{"Named", "Random1", "Random2"} {
+		resp := doRequest(h, `{"query":"query `+name+` { name }","operationName":"`+name+`"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	}
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	body := w.Body.String()
+	assert.Contains(t, body,
+		`gqlgen_operations_total{operation_type="query",operation_name="Named"} 1`+"\n")
+	assert.Contains(t, body,
+		`gqlgen_operations_total{operation_type="query",operation_name="other"} 2`+"\n")
+	assert.NotContains(t, body, "Random")
+}
+
+func TestMetricsValidate(t *testing.T) {
+	h := testserver.New()
+
+	require.PanicsWithError(t, `field coordinate "Query.missing" does not exist in the schema`,
+		func() {
+			h.Use(&metrics.Metrics{FieldCoordinates: []string{"Query.missing"}})
+		})
+	require.PanicsWithError(t, `invalid field coordinate "name", expected Type.field`, func() {
+		h.Use(&metrics.Metrics{FieldCoordinates: []string{"name"}})
+	})
+	require.PanicsWithError(t, "Buckets must be in strictly increasing order, got [1 0.5]",
+		func() {
+			h.Use(&metrics.Metrics{Buckets: []float64{1, .5}})
+		})
+	require.PanicsWithError(t, "ComplexityBuckets must be in strictly increasing order, got [5 5]",
+		func() {
+			h.Use(&metrics.Metrics{ComplexityBuckets: []float64{5, 5}})
+		})
+}
+
+func TestDefaultBucketsAreCopies(t *testing.T) {
+	metrics.DefaultBuckets()[0] = 100
+	require.InDelta(t, .001, metrics.DefaultBuckets()[0], 0)
+}
+
+func doRequest(handler http.Handler, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+	return w
+}
@@ -0,0 +1,276 @@
+package oteltracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ScopeName is the instrumentation scope used for tracers created by this package.
+const ScopeName = "github.com/99designs/gqlgen/graphql/handler/oteltracing"
+
+// Attribute keys set on the spans emitted by Tracer. The operation attributes follow the
+// OpenTelemetry semantic conventions for GraphQL servers.
+const (
+	OperationNameKey   = attribute.Key("graphql.operation.name")
+	OperationTypeKey   = attribute.Key("graphql.operation.type")
+	DocumentKey        = attribute.Key("graphql.document")
+	ErrorCodesKey      = attribute.Key("graphql.error.codes")
+	FieldNameKey       = attribute.Key("graphql.field.name")
+	FieldPathKey       = attribute.Key("graphql.field.path")
+	FieldParentTypeKey = attribute.Key("graphql.field.parent_type")
+	FieldTypeKey       = attribute.Key("graphql.field.type")
+)
+
+type ctxKey string
+
+const operationSpanCtx ctxKey = "operation_span"
+
+// Tracer is a HandlerExtension that emits OpenTelemetry spans for each graphql operation. Every
+// operation gets a span named after its type and name, with child spans for reading, parsing and
+// validating the request, one span per root field covering its whole execution, and one span per
+// traced field resolver. Resolver spans are parented through the context handed to children, so
+// the span tree mirrors the shape of the response.
+type Tracer struct {
+	// TracerProvider creates the tracer used by this extension. When nil the global provider
+	// from otel.GetTracerProvider is used.
+	TracerProvider trace.TracerProvider
+
+	// FieldFilter reports whether a span should be emitted for the resolver of the field in ctx.
+	// When nil, spans are only emitted for fields with a user defined resolver
+	// (FieldContext.IsResolver). Return false to skip a field, or to sample high volume
+	// resolvers.
+	FieldFilter func(ctx context.Context, fc *graphql.FieldContext) bool
+
+	// IncludeDocument records the query document on the operation span, with the literal values
+	// of @sensitive arguments and input fields redacted. It is off by default because documents
+	// can be large.
+	IncludeDocument bool
+
+	tracer trace.Tracer
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.RootFieldInterceptor
+	graphql.FieldInterceptor
+} = &Tracer{}
+
+func (t *Tracer) ExtensionName() string {
+	return "OpenTelemetryTracing"
+}
+
+func (t *Tracer) Validate(graphql.ExecutableSchema) error {
+	provider := t.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	t.tracer = provider.Tracer(ScopeName, trace.WithInstrumentationVersion(graphql.Version))
+	return nil
+}
+
+func (t *Tracer) InterceptOperation(
+	ctx context.Context,
+	next graphql.OperationHandler,
+) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+
+	ctx, span := t.startOperationSpan(ctx, opCtx)
+	ctx = context.WithValue(ctx, operationSpanCtx, span)
+
+	responses := next(ctx)
+	isSubscription := opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription
+
+	// A query or mutation produces a single response, or several when @defer is used. A
+	// subscription keeps the span open until the stream is closed.
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			span.End()
+			return nil
+		}
+
+		recordErrors(span, resp.Errors)
+		if !isSubscription && (resp.HasNext == nil || !*resp.HasNext) {
+			span.End()
+		}
+		return resp
+	}
+}
+
+// InterceptResponse records operations rejected before execution, for example because they
+// failed to parse or validate. Those never reach InterceptOperation, so the operation span is
+// created and ended here.
+func (t *Tracer) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if _, ok := ctx.Value(operationSpanCtx).(trace.Span); ok || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	_, span := t.startOperationSpan(ctx, graphql.GetOperationContext(ctx))
+	resp := next(ctx)
+	if resp != nil {
+		recordErrors(span, resp.Errors)
+	}
+	span.End()
+
+	return resp
+}
+
+func (t *Tracer) InterceptRootField(
+	ctx context.Context,
+	next graphql.RootResolver,
+) graphql.Marshaler {
+	rc := graphql.GetRootFieldContext(ctx)
+	if rc == nil {
+		return next(ctx)
+	}
+
+	attrs := []attribute.KeyValue{
+		FieldNameKey.String(rc.Field.Name),
+		FieldPathKey.String(ast.Path{ast.PathName(rc.Field.Alias)}.String()),
+		FieldParentTypeKey.String(rc.Object),
+	}
+	if rc.Field.Definition != nil {
+		attrs = append(attrs, FieldTypeKey.String(rc.Field.Definition.Type.String()))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "execute "+rc.Object+"."+rc.Field.Name,
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	return next(ctx)
+}
+
+func (t *Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !t.traceField(ctx, fc) {
+		return next(ctx)
+	}
+
+	attrs := []attribute.KeyValue{
+		FieldNameKey.String(fc.Field.Name),
+		FieldPathKey.String(fc.Path().String()),
+		FieldParentTypeKey.String(fc.Object),
+	}
+	if fc.Field.Definition != nil {
+		attrs = append(attrs, FieldTypeKey.String(fc.Field.Definition.Type.String()))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "resolve "+fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		var gqlErr *gqlerror.Error
+		if errors.As(err, &gqlErr) {
+			recordErrors(span, gqlerror.List{gqlErr})
+		} else {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+
+	return res, err
+}
+
+func (t *Tracer) traceField(ctx context.Context, fc *graphql.FieldContext) bool {
+	if t.FieldFilter != nil {
+		return t.FieldFilter(ctx, fc)
+	}
+	return fc.IsResolver
+}
+
+// startOperationSpan starts the span for opCtx at the time the operation trace started, and
+// back-fills child spans for the read, parse and validation phases from opCtx.Stats.
+func (t *Tracer) startOperationSpan(
+	ctx context.Context,
+	opCtx *graphql.OperationContext,
+) (context.Context, trace.Span) {
+	opType := ""
+	if opCtx.Operation != nil {
+		opType = string(opCtx.Operation.Operation)
+	}
+
+	name := "graphql"
+	switch {
+	case opType != "" && opCtx.OperationName != "":
+		name = opType + " " + opCtx.OperationName
+	case opType != "":
+		name = opType
+	}
+
+	attrs := []attribute.KeyValue{}
+	if opType != "" {
+		attrs = append(attrs, OperationTypeKey.String(opType))
+	}
+	if opCtx.OperationName != "" {
+		attrs = append(attrs, OperationNameKey.String(opCtx.OperationName))
+	}
+	if t.IncludeDocument {
+		if doc := opCtx.Sensitivity.RedactQuery(opCtx); doc != "" {
+			attrs = append(attrs, DocumentKey.String(doc))
+		}
+	}
+
+	opts := []trace.SpanStartOption{trace.WithAttributes(attrs...)}
+	if !opCtx.Stats.OperationStart.IsZero() {
+		opts = append(opts, trace.WithTimestamp(opCtx.Stats.OperationStart))
+	}
+
+	ctx, span := t.tracer.Start(ctx, name, opts...)
+
+	t.recordTiming(ctx, "graphql.read", opCtx.Stats.Read)
+	t.recordTiming(ctx, "graphql.parse", opCtx.Stats.Parsing)
+	t.recordTiming(ctx, "graphql.validate", opCtx.Stats.Validation)
+
+	return ctx, span
+}
+
+func (t *Tracer) recordTiming(ctx context.Context, name string, timing graphql.TraceTiming) {
+	if timing.Start.IsZero() {
+		return
+	}
+	end := timing.End
+	if end.IsZero() {
+		// the phase was aborted by an error, close the span where the operation stopped
+		end = graphql.Now()
+	}
+
+	_, span := t.tracer.Start(ctx, name, trace.WithTimestamp(timing.Start))
+	span.End(trace.WithTimestamp(end))
+}
+
+// recordErrors marks span as failed and records the extensions.code of each error.
+func recordErrors(span trace.Span, errs gqlerror.List) {
+	if len(errs) == 0 {
+		return
+	}
+
+	var errCodes []string
+	for _, err := range errs {
+		span.RecordError(err)
+		if code, ok := err.Extensions["code"].(string); ok {
+			errCodes = append(errCodes, code)
+		}
+	}
+	if len(errCodes) > 0 {
+		span.SetAttributes(ErrorCodesKey.StringSlice(errCodes))
+	}
+	span.SetStatus(codes.Error, errs[0].Message)
+}
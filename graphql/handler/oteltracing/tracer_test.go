@@ -0,0 +1,150 @@
+package oteltracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/oteltracing"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestTracer(t *testing.T) {
+	t.Run("operation with resolver spans", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		h := newServer(exporter, &oteltracing.Tracer{
+			FieldFilter: func(ctx context.Context, fc *graphql.FieldContext) bool {
+				return true
+			},
+		})
+
+		resp := doRequest(h, `{"query":"query Named { name }","operationName":"Named"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		spans := spansByName(exporter.GetSpans())
+		require.Len(t, spans, 5)
+
+		op := spans["query Named"]
+		require.NotNil(t, op)
+		assert.Contains(t, op.Attributes, oteltracing.OperationTypeKey.String("query"))
+		assert.Contains(t, op.Attributes, oteltracing.OperationNameKey.String("Named"))
+		assert.Equal(t, codes.Unset, op.Status.Code)
+
+		for _, name := range []string{"graphql.read", "graphql.parse", "graphql.validate"} {
+			require.NotNil(t, spans[name], name)
+			assert.Equal(t, op.SpanContext.SpanID(), spans[name].Parent.SpanID(), name)
+		}
+
+		field := spans["resolve Query.name"]
+		require.NotNil(t, field)
+		assert.Equal(t, op.SpanContext.SpanID(), field.Parent.SpanID())
+		assert.Contains(t, field.Attributes, oteltracing.FieldPathKey.String("name"))
+		assert.Contains(t, field.Attributes, oteltracing.FieldTypeKey.String("String!"))
+	})
+
+	t.Run("trivial fields are not traced by default", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		h := newServer(exporter, &oteltracing.Tracer{})
+
+		resp := doRequest(h, `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		spans := spansByName(exporter.GetSpans())
+		assert.NotContains(t, spans, "resolve Query.name")
+		assert.Contains(t, spans, "query")
+	})
+
+	t.Run("rejected operation", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		h := newServer(exporter, &oteltracing.Tracer{IncludeDocument: true})
+
+		resp := doRequest(h, `{"query":"{ unknownField }"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+		spans := spansByName(exporter.GetSpans())
+		op := spans["graphql"]
+		require.NotNil(t, op)
+		assert.Equal(t, codes.Error, op.Status.Code)
+		assert.Contains(t, op.Attributes,
+			oteltracing.ErrorCodesKey.StringSlice([]string{"GRAPHQL_VALIDATION_FAILED"}))
+		assert.Contains(t, op.Attributes, oteltracing.DocumentKey.String("{ unknownField }"))
+		require.NotNil(t, spans["graphql.validate"])
+	})
+
+	t.Run("root field span parents resolver spans", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		tracer := &oteltracing.Tracer{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+		}
+		require.NoError(t, tracer.Validate(nil))
+
+		field := graphql.CollectedField{Field: &ast.Field{Name: "user", Alias: "me"}}
+		ctx := graphql.WithRootFieldContext(context.Background(), &graphql.RootFieldContext{
+			Object: "Query",
+			Field:  field,
+		})
+
+		tracer.InterceptRootField(ctx, func(ctx context.Context) graphql.Marshaler {
+			ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+				Object:     "Query",
+				Field:      field,
+				IsResolver: true,
+			})
+			_, err := tracer.InterceptField(ctx, func(ctx context.Context) (any, error) {
+				return "bob", nil
+			})
+			require.NoError(t, err)
+			return graphql.Null
+		})
+
+		spans := spansByName(exporter.GetSpans())
+		root := spans["execute Query.user"]
+		require.NotNil(t, root)
+		resolver := spans["resolve Query.user"]
+		require.NotNil(t, resolver)
+		assert.Equal(t, root.SpanContext.SpanID(), resolver.Parent.SpanID())
+		assert.Contains(t, root.Attributes, attribute.String("graphql.field.name", "user"))
+		assert.Contains(t, root.Attributes, attribute.String("graphql.field.path", "me"))
+		assert.Contains(t, resolver.Attributes, attribute.String("graphql.field.path", "me"))
+	})
+}
+
+func newServer(exporter *tracetest.InMemoryExporter, tracer *oteltracing.Tracer) http.Handler {
+	if tracer.TracerProvider == nil {
+		tracer.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	}
+
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(tracer)
+	return h
+}
+
+func spansByName(spans tracetest.SpanStubs) map[string]*tracetest.SpanStub {
+	byName := map[string]*tracetest.SpanStub{}
+	for i := range spans {
+		byName[spans[i].Name] = &spans[i]
+	}
+	return byName
+}
+
+func doRequest(handler http.Handler, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+	return w
+}
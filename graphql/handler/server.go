@@ -13,6 +13,7 @@ import (
 	"github.com/vektah/gqlparser/v2/validator/rules"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/99designs/gqlgen/graphql/executor"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -47,7 +48,8 @@ func New(es graphql.ExecutableSchema) *Server {
 //
 // SSE is not supported using this example. SSE when used over HTTP/1.1 (but not
 // HTTP/2 or HTTP/3) suffers from a severe limitation to the maximum number of
-// open connections of 6 per browser, see [Using server-sent events].
+// open connections of 6 per browser, see [Using server-sent events], unless
+// the SingleConnection mode of transport.SSE is enabled.
 //
 // [Using server-sent events]:
 // https://developer.mozilla.org/en-US/docs/Web/API/Server-sent_events/Using_server-sent_events#sect1
@@ -87,6 +89,12 @@ func (s *Server) SetRecoverFunc(f graphql.RecoverFunc) {
 	s.exec.SetRecoverFunc(f)
 }
 
+// ErrorRegistry returns the registry mapping error codes to HTTP status codes and websocket
+// message types for this server.
+func (s *Server) ErrorRegistry() *errcode.Registry {
+	return s.exec.ErrorRegistry()
+}
+
 func (s *Server) SetQueryCache(cache graphql.Cache[*ast.QueryDocument]) {
 	s.exec.SetQueryCache(cache)
 }
@@ -99,6 +107,12 @@ func (s *Server) SetDisableSuggestion(value bool) {
 	s.exec.SetDisableSuggestion(value)
 }
 
+// SetDefaultErrorBehavior sets the behavior on field errors of requests not selecting one with
+// the onError request extension. It defaults to graphql.ErrorBehaviorPropagate.
+func (s *Server) SetDefaultErrorBehavior(behavior graphql.ErrorBehavior) {
+	s.exec.SetDefaultErrorBehavior(behavior)
+}
+
 // Use adds the given extension middleware to the server. Extensions are run in
 // order from first to last added.
 func (s *Server) Use(extension graphql.HandlerExtension) {
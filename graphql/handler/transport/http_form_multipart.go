@@ -215,13 +215,14 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		End:   graphql.Now(),
 	}
 
-	rc, gerr := exec.CreateOperationContext(r.Context(), &params)
+	ctx := graphql.WithResponseHeader(r.Context(), w.Header())
+	rc, gerr := exec.CreateOperationContext(ctx, &params)
 	if gerr != nil {
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), gerr)
-		w.WriteHeader(statusFor(gerr))
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), gerr)
+		w.WriteHeader(statusFor(exec, gerr))
 		writeJson(w, resp)
 		return
 	}
-	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	writeJsonResponse(w, exec, responses(ctx))
 }
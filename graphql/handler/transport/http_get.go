@@ -55,6 +55,7 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		h.ResponseHeaders,
 	)
 	writeHeaders(w, responseHeaders)
+	ctx := graphql.WithResponseHeader(r.Context(), w.Header())
 
 	raw := &graphql.RawParams{
 		Query:         query.Get("query"),
@@ -81,14 +82,14 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 
 	raw.ReadTime.End = graphql.Now()
 
-	opCtx, gqlError := exec.CreateOperationContext(r.Context(), raw)
+	opCtx, gqlError := exec.CreateOperationContext(ctx, raw)
 	if gqlError != nil {
 		if contentType == acceptApplicationGraphqlResponseJson {
-			w.WriteHeader(statusForGraphQLResponse(gqlError))
+			w.WriteHeader(statusForGraphQLResponse(exec, gqlError))
 		} else {
-			w.WriteHeader(statusFor(gqlError))
+			w.WriteHeader(statusFor(exec, gqlError))
 		}
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), opCtx), gqlError)
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), gqlError)
 		writeJson(w, resp)
 		return
 	}
@@ -99,8 +100,8 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		return
 	}
 
-	responses, ctx := exec.DispatchOperation(r.Context(), opCtx)
-	writeJson(w, responses(ctx))
+	responses, ctx := exec.DispatchOperation(ctx, opCtx)
+	writeJsonResponse(w, exec, responses(ctx))
 }
 
 func jsonDecode(r io.Reader, val any) error {
@@ -109,8 +110,12 @@ func jsonDecode(r io.Reader, val any) error {
 	return dec.Decode(val)
 }
 
-func statusFor(errs gqlerror.List) int {
-	switch errcode.GetErrorKind(errs) {
+func statusFor(exec graphql.GraphExecutor, errs gqlerror.List) int {
+	registry := errorRegistry(exec)
+	if status := registry.Status(errs); status != 0 {
+		return status
+	}
+	switch registry.Kind(errs) {
 	case errcode.KindProtocol:
 		return http.StatusUnprocessableEntity
 	default:
@@ -118,9 +123,13 @@ func statusFor(errs gqlerror.List) int {
 	}
 }
 
-func statusForGraphQLResponse(errs gqlerror.List) int {
+func statusForGraphQLResponse(exec graphql.GraphExecutor, errs gqlerror.List) int {
 	// https://graphql.github.io/graphql-over-http/draft/#sec-application-graphql-response-json
-	switch errcode.GetErrorKind(errs) {
+	registry := errorRegistry(exec)
+	if status := registry.Status(errs); status != 0 {
+		return status
+	}
+	switch registry.Kind(errs) {
 	case errcode.KindProtocol:
 		return http.StatusBadRequest
 	default:
@@ -113,7 +113,7 @@ func (t MultipartMixed) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	rc, opErr := exec.CreateOperationContext(ctx, params)
 	ctx = graphql.WithOperationContext(ctx, rc)
 	if opErr != nil {
-		w.WriteHeader(statusFor(opErr))
+		w.WriteHeader(statusFor(exec, opErr))
 
 		resp := exec.DispatchError(ctx, opErr)
 		writeJson(w, resp)
@@ -156,21 +156,6 @@ func (t MultipartMixed) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	}
 }
 
-func writeIncrementalJson(w io.Writer, responses []*graphql.Response, hasNext bool) {
-	// TODO: Remove this wrapper on response once gqlgen supports the 2023 spec
-	b, err := json.Marshal(struct {
-		Incremental []*graphql.Response `json:"incremental"`
-		HasNext     bool                `json:"hasNext"`
-	}{
-		Incremental: responses,
-		HasNext:     hasNext,
-	})
-	if err != nil {
-		panic(err)
-	}
-	w.Write(b)
-}
-
 func writeBoundary(w io.Writer, boundary string, finalResponse bool) {
 	if finalResponse {
 		fmt.Fprintf(w, "--%s--\r\n", boundary)
@@ -286,9 +271,13 @@ func (a *multipartResponseAggregator) flush(w http.ResponseWriter) {
 		// TODO: use the "HasNext" status of deferResponses items to determine
 		// the operation status and pending / complete fields, but remove from
 		// the incremental (deferResponses) object.
-		hasNext = a.deferResponses[len(a.deferResponses)-1].HasNext != nil &&
-			*a.deferResponses[len(a.deferResponses)-1].HasNext
-		writeIncrementalJson(w, a.deferResponses, hasNext)
+		payload := newIncrementalPayload(a.deferResponses)
+		hasNext = payload.HasNext
+		b, err := json.Marshal(payload)
+		if err != nil {
+			panic(err)
+		}
+		w.Write(b)
 
 		// Reset the deferResponses so we don't send them again
 		a.deferResponses = nil
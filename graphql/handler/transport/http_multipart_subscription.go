@@ -0,0 +1,260 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MultipartSubscription is a transport serving operations, usually subscriptions, with the
+// multipart HTTP subscription protocol of Apollo Client and Router:
+// https://www.apollographql.com/docs/graphos/routing/operations/subscriptions/multipart-protocol
+//
+// Each response is sent as a part of a multipart/mixed response, wrapped in a payload field.
+// Heartbeat parts keep the connection open through proxies that would close it, and errors ending
+// the operation early, as added with AddSubscriptionError, are sent in the errors field of a last
+// part with a null payload.
+//
+// Its requests are also accepted by MultipartMixed, so it must be added to the server first.
+type MultipartSubscription struct {
+	// HeartbeatInterval is the interval between heartbeat parts. It defaults to 5 seconds, and a
+	// negative interval disables the heartbeats.
+	HeartbeatInterval time.Duration
+}
+
+var _ graphql.Transport = MultipartSubscription{}
+
+const (
+	multipartSubscriptionBoundary  = "graphql"
+	multipartSubscriptionHeartbeat = 5 * time.Second
+)
+
+// multipartSubscriptionMessage is the body of a part of a multipart subscription response.
+// Heartbeats are empty objects.
+type multipartSubscriptionMessage struct {
+	Payload any           `json:"payload"`
+	Errors  gqlerror.List `json:"errors,omitempty"`
+}
+
+// Supports checks if the request accepts multipart/mixed responses with the subscriptionSpec
+// parameter.
+func (t MultipartSubscription) Supports(r *http.Request) bool {
+	if !acceptsMultipartSubscription(r.Header.Get("Accept")) {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return r.Method == http.MethodPost && mediaType == "application/json"
+}
+
+func acceptsMultipartSubscription(accept string) bool {
+	for mediaRange := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		if mediaType == "multipart/mixed" && params["subscriptionspec"] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Do runs the operation, sending each of its responses as a part of a multipart/mixed response.
+func (t MultipartSubscription) Do(
+	w http.ResponseWriter,
+	r *http.Request,
+	exec graphql.GraphExecutor,
+) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Requests failing before the operation runs get a plain JSON response.
+	w.Header().Set("Content-Type", "application/json")
+
+	params := &graphql.RawParams{}
+	start := graphql.Now()
+
+	bodyString, err := getRequestBody(r)
+	if err != nil {
+		gqlErr := gqlerror.Errorf("could not get json request body: %+v", err)
+		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+		log.Printf("could not get json request body: %+v", err.Error())
+		writeJson(w, resp)
+		return
+	}
+
+	bodyReader := io.NopCloser(strings.NewReader(bodyString))
+	if err = jsonDecode(bodyReader, params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf(
+			"json request body could not be decoded: %+v body:%s",
+			err,
+			bodyString,
+		)
+		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+		log.Printf("decoding error: %+v body:%s", err.Error(), bodyString)
+		writeJson(w, resp)
+		return
+	}
+
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = graphql.WithOperationContext(ctx, rc)
+	if opErr != nil {
+		w.WriteHeader(statusFor(exec, opErr))
+
+		resp := exec.DispatchError(ctx, opErr)
+		writeJson(w, resp)
+		return
+	}
+
+	// Every part is followed by a delimiter, so that clients handle it without waiting for the
+	// next one. The last part, a heartbeat unless the operation failed, is followed by the closing
+	// delimiter:
+	//
+	// --graphql
+	// Content-Type: application/json
+	//
+	// {"payload":{"data":{"name":"a"}}}
+	// --graphql
+	// Content-Type: application/json
+	//
+	// {}
+	// --graphql--
+	w.Header().Set(
+		"Content-Type",
+		fmt.Sprintf(
+			`multipart/mixed;boundary="%s";subscriptionSpec="1.0"`,
+			multipartSubscriptionBoundary,
+		),
+	)
+	c := &multipartSubscriptionConnection{w: w, f: flusher}
+	c.write(func(w io.Writer) {
+		writeBoundary(w, multipartSubscriptionBoundary, false)
+	})
+
+	ctx = withSubscriptionErrorContext(ctx)
+	heartbeat := t.HeartbeatInterval
+	if heartbeat == 0 {
+		heartbeat = multipartSubscriptionHeartbeat
+	}
+	stopHeartbeat := c.startHeartbeat(ctx, heartbeat)
+
+	defer func() {
+		var errs []*gqlerror.Error
+		if r := recover(); r != nil {
+			err := rc.Recover(ctx, r)
+			var gqlErr *gqlerror.Error
+			if !errors.As(err, &gqlErr) {
+				gqlErr = &gqlerror.Error{}
+				if err != nil {
+					gqlErr.Message = err.Error()
+				}
+			}
+			errs = append(errs, gqlErr)
+		}
+		errs = append(errs, getSubscriptionError(ctx)...)
+
+		stopHeartbeat()
+		if len(errs) > 0 {
+			c.writePart(multipartSubscriptionMessage{Errors: errs}, true)
+		} else {
+			c.writePart(struct{}{}, true)
+		}
+	}()
+
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	var delivery incrementalDelivery
+	for {
+		response := responses(ctx)
+		if response == nil {
+			break
+		}
+		c.writePart(multipartSubscriptionMessage{Payload: delivery.payload(response)}, false)
+	}
+}
+
+// multipartSubscriptionConnection serializes the parts written by an operation and its heartbeats.
+type multipartSubscriptionConnection struct {
+	mu sync.Mutex
+	w  io.Writer
+	f  http.Flusher
+}
+
+func (c *multipartSubscriptionConnection) write(write func(w io.Writer)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	write(c.w)
+	c.f.Flush()
+}
+
+// writePart writes a part with the JSON encoding of body, followed by a delimiter, or by the
+// closing delimiter for the last part.
+func (c *multipartSubscriptionConnection) writePart(body any, last bool) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		panic(err)
+	}
+	c.write(func(w io.Writer) {
+		writeContentTypeHeader(w)
+		w.Write(b)
+		fmt.Fprint(w, "\r\n")
+		writeBoundary(w, multipartSubscriptionBoundary, last)
+	})
+}
+
+// startHeartbeat writes heartbeat parts every interval until the returned function is called.
+// Intervals that are not positive disable the heartbeats.
+func (c *multipartSubscriptionConnection) startHeartbeat(
+	ctx context.Context,
+	interval time.Duration,
+) (stop func()) {
+	if interval <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.writePart(struct{}{}, false)
+			}
+		}
+	})
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
@@ -0,0 +1,187 @@
+package transport_test
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestMultipartSubscription(t *testing.T) {
+	const accept = `multipart/mixed;boundary="graphql";subscriptionSpec="1.0", application/json`
+
+	initialize := func(tr transport.MultipartSubscription) *testserver.TestServer {
+		h := testserver.New()
+		h.AddTransport(tr)
+		h.AddTransport(transport.MultipartMixed{Boundary: "graphql"})
+		return h
+	}
+
+	createHTTPRequest := func(url string, query string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(query))
+		require.NoError(t, err, "Request threw error -> %s", err)
+		req.Header.Set("Accept", accept)
+		req.Header.Set("content-type", "application/json; charset=utf-8")
+		return req
+	}
+
+	readPart := func(t *testing.T, mr *multipart.Reader) string {
+		t.Helper()
+		part, err := mr.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", part.Header.Get("Content-Type"))
+		b, err := io.ReadAll(part)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	t.Run("supports", func(t *testing.T) {
+		tr := transport.MultipartSubscription{}
+		req := createHTTPRequest("/", `{}`)
+		assert.True(t, tr.Supports(req))
+
+		req.Header.Set("Accept", "multipart/mixed;deferSpec=20220824, application/json")
+		assert.False(t, tr.Supports(req))
+
+		req.Header.Set("Accept", accept)
+		req.Method = http.MethodGet
+		assert.False(t, tr.Supports(req))
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		h := initialize(transport.MultipartSubscription{})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, createHTTPRequest("/", `{"query": "subscription { title }"}`))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code, w.Body.String())
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(
+			t,
+			`{"errors":[{"message":"Cannot query field \"title\" on type \"Subscription\".","locations":[{"line":1,"column":16}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`,
+			w.Body.String(),
+		)
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		h := initialize(transport.MultipartSubscription{HeartbeatInterval: -1})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			h.SendNextSubscriptionMessage()
+		})
+
+		res, err := http.DefaultClient.Do(
+			createHTTPRequest(srv.URL, `{"query":"subscription { name }"}`),
+		)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		mediaType, params, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/mixed", mediaType)
+		assert.Equal(t, map[string]string{
+			"boundary":         "graphql",
+			"subscriptionspec": "1.0",
+		}, params)
+
+		mr := multipart.NewReader(res.Body, "graphql")
+		assert.JSONEq(t, `{"payload":{"data":{"name":"test"}}}`, readPart(t, mr))
+
+		wg.Go(func() {
+			h.SendNextSubscriptionMessage()
+		})
+		assert.JSONEq(t, `{"payload":{"data":{"name":"test"}}}`, readPart(t, mr))
+
+		wg.Go(func() {
+			h.SendCompleteSubscriptionMessage()
+		})
+		assert.JSONEq(t, `{}`, readPart(t, mr))
+		_, err = mr.NextPart()
+		require.ErrorIs(t, err, io.EOF)
+
+		wg.Wait()
+	})
+
+	t.Run("sends heartbeats", func(t *testing.T) {
+		h := initialize(transport.MultipartSubscription{HeartbeatInterval: time.Millisecond})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		res, err := http.DefaultClient.Do(
+			createHTTPRequest(srv.URL, `{"query":"subscription { name }"}`),
+		)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		mr := multipart.NewReader(res.Body, "graphql")
+		assert.JSONEq(t, `{}`, readPart(t, mr))
+
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			h.SendNextSubscriptionMessage()
+		})
+		for {
+			part := readPart(t, mr)
+			if part != `{}` {
+				assert.JSONEq(t, `{"payload":{"data":{"name":"test"}}}`, part)
+				break
+			}
+		}
+		wg.Wait()
+	})
+
+	t.Run("sends subscription errors", func(t *testing.T) {
+		h := handler.New(&graphql.ExecutableSchemaMock{
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				return func(ctx context.Context) *graphql.Response {
+					transport.AddSubscriptionError(ctx, &gqlerror.Error{Message: "stream failed"})
+					return nil
+				}
+			},
+			SchemaFunc: func() *ast.Schema {
+				return gqlparser.MustLoadSchema(&ast.Source{Input: `
+					type Query { name: String! }
+					type Subscription { name: String! }
+				`})
+			},
+		})
+		h.AddTransport(transport.MultipartSubscription{HeartbeatInterval: -1})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, createHTTPRequest("/", `{"query":"subscription { name }"}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(
+			t,
+			"--graphql\r\n"+
+				"Content-Type: application/json\r\n\r\n"+
+				`{"payload":null,"errors":[{"message":"stream failed"}]}`+"\r\n"+
+				"--graphql--\r\n",
+			w.Body.String(),
+		)
+	})
+}
@@ -24,6 +24,9 @@ type POST struct {
 	// as the response content type
 	// when the Accept header is empty or 'application/*' or '*/*'.
 	UseGrapQLResponseJsonByDefault bool
+
+	// Batching, when set, enables batches of operations sent as a JSON array.
+	Batching *Batching
 }
 
 var _ graphql.Transport = POST{}
@@ -59,7 +62,7 @@ var pool = sync.Pool{
 }
 
 func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
-	ctx := r.Context()
+	ctx := graphql.WithResponseHeader(r.Context(), w.Header())
 	contentType := determineResponseContentType(
 		h.ResponseHeaders,
 		r,
@@ -93,6 +96,11 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		return
 	}
 
+	if h.Batching != nil && isBatch(bodyBytes) {
+		h.Batching.do(w, r, bodyBytes, exec)
+		return
+	}
+
 	bodyReader := bytes.NewReader(bodyBytes)
 	if err := jsonDecode(bodyReader, params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -115,9 +123,9 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 	rc, opErr := exec.CreateOperationContext(ctx, params)
 	if opErr != nil {
 		if contentType == acceptApplicationGraphqlResponseJson {
-			w.WriteHeader(statusForGraphQLResponse(opErr))
+			w.WriteHeader(statusForGraphQLResponse(exec, opErr))
 		} else {
-			w.WriteHeader(statusFor(opErr))
+			w.WriteHeader(statusFor(exec, opErr))
 		}
 		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr)
 		writeJson(w, resp)
@@ -126,5 +134,5 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	writeJsonResponse(w, exec, responses(ctx))
 }
@@ -0,0 +1,210 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Batching configures the POST transport to also accept a JSON array of operations, as sent by
+// the batching links of Apollo Client and urql, and to respond with the JSON array of their
+// responses, in the same order.
+//
+// The operations of a batch can not set response headers, and can not use @defer or @stream, as
+// a batch has a single response: they are answered with an error instead.
+type Batching struct {
+	// MaxBatchSize is the maximum number of operations in a batch, 10 if zero. Larger batches
+	// are rejected with a 400 status code.
+	MaxBatchSize int
+
+	// MaxConcurrency is the maximum number of operations of a batch executed concurrently, 4 if
+	// zero.
+	MaxConcurrency int
+
+	// ComplexityBudget, when set, is the complexity shared by the operations of a batch. The
+	// ComplexityLimit extension rejects the operations exceeding what the operations before them
+	// in the batch left of it.
+	ComplexityBudget int
+}
+
+// isBatch reports whether body is a JSON array.
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+// batchOperation is an operation of a batch ready to be executed.
+type batchOperation struct {
+	index int
+	ctx   context.Context
+	opCtx *graphql.OperationContext
+}
+
+// do executes the batch of operations in body. The operation contexts are created in the order
+// of the batch, so that it decides which operations fit in the complexity budget, then the
+// operations are executed concurrently.
+func (b *Batching) do(
+	w http.ResponseWriter,
+	r *http.Request,
+	body []byte,
+	exec graphql.GraphExecutor,
+) {
+	ctx := r.Context()
+
+	var rawOperations []json.RawMessage
+	if err := jsonDecode(bytes.NewReader(body), &rawOperations); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf(
+			"json request body could not be decoded: %+v body:%s",
+			err,
+			string(body),
+		)
+		writeJson(w, exec.DispatchError(ctx, gqlerror.List{gqlErr}))
+		return
+	}
+
+	maxBatchSize := b.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = 10
+	}
+	switch {
+	case len(rawOperations) == 0:
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonError(w, "batch must contain at least one operation")
+		return
+	case len(rawOperations) > maxBatchSize:
+		w.WriteHeader(http.StatusBadRequest)
+		writeJsonErrorf(w, "batch of %d operations exceeds the limit of %d",
+			len(rawOperations), maxBatchSize)
+		return
+	}
+
+	if b.ComplexityBudget > 0 {
+		ctx = graphql.WithComplexityBudget(ctx, b.ComplexityBudget)
+	}
+
+	responses := make([]*graphql.Response, len(rawOperations))
+	operations := make([]batchOperation, 0, len(rawOperations))
+	for i, raw := range rawOperations {
+		ctx := graphql.StartOperationTrace(ctx)
+		start := graphql.Now()
+
+		params := &graphql.RawParams{}
+		if err := jsonDecode(bytes.NewReader(raw), params); err != nil {
+			gqlErr := gqlerror.Errorf("json request body could not be decoded: %+v", err)
+			responses[i] = exec.DispatchError(ctx, gqlerror.List{gqlErr})
+			continue
+		}
+		params.Headers = r.Header
+		params.ReadTime = graphql.TraceTiming{
+			Start: start,
+			End:   graphql.Now(),
+		}
+
+		opCtx, opErr := exec.CreateOperationContext(ctx, params)
+		if opErr != nil {
+			responses[i] = exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), opErr)
+			continue
+		}
+		if usesIncrementalDelivery(opCtx) {
+			gqlErr := gqlerror.Errorf("@defer and @stream are not supported in batched operations")
+			responses[i] = exec.DispatchError(
+				graphql.WithOperationContext(ctx, opCtx),
+				gqlerror.List{gqlErr},
+			)
+			continue
+		}
+		operations = append(operations, batchOperation{index: i, ctx: ctx, opCtx: opCtx})
+	}
+
+	maxConcurrency := b.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = 4
+	}
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for _, op := range operations {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			handler, ctx := exec.DispatchOperation(op.ctx, op.opCtx)
+			responses[op.index] = handler(ctx)
+		})
+	}
+	wg.Wait()
+
+	writeJsonBatch(w, responses)
+}
+
+// usesIncrementalDelivery reports whether the operation of opCtx, or a fragment it spreads,
+// has an enabled @defer or @stream directive.
+func usesIncrementalDelivery(opCtx *graphql.OperationContext) bool {
+	visited := map[string]bool{}
+	var walk func(ast.SelectionSet) bool
+	walk = func(selectionSet ast.SelectionSet) bool {
+		for _, sel := range selectionSet {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				if incrementalDirective(sel.Directives, opCtx.Variables) ||
+					walk(sel.SelectionSet) {
+					return true
+				}
+			case *ast.InlineFragment:
+				if incrementalDirective(sel.Directives, opCtx.Variables) ||
+					walk(sel.SelectionSet) {
+					return true
+				}
+			case *ast.FragmentSpread:
+				if incrementalDirective(sel.Directives, opCtx.Variables) {
+					return true
+				}
+				if visited[sel.Name] {
+					continue
+				}
+				visited[sel.Name] = true
+				if fragment := opCtx.Doc.Fragments.ForName(sel.Name); fragment != nil &&
+					walk(fragment.SelectionSet) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return walk(opCtx.Operation.SelectionSet)
+}
+
+// incrementalDirective reports whether directives has a @defer or @stream directive whose if
+// argument is not false.
+func incrementalDirective(directives ast.DirectiveList, variables map[string]any) bool {
+	for _, d := range directives {
+		if d.Name != "defer" && d.Name != graphql.StreamDirective {
+			continue
+		}
+		arg := d.Arguments.ForName("if")
+		if arg == nil {
+			return true
+		}
+		value, err := arg.Value.Value(variables)
+		if enabled, ok := value.(bool); err != nil || !ok || enabled {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJsonBatch(w io.Writer, responses []*graphql.Response) {
+	res, err := json.Marshal(responses)
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal batch responses: %w", err))
+	}
+	w.Write(res)
+}
@@ -0,0 +1,105 @@
+package transport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestPOSTBatching(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{Batching: &transport.Batching{MaxBatchSize: 3}})
+
+	t.Run("responses in the order of the batch", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`[{"query":"{ name }"},{"query":"{ missing }"},{"query":"{ name }"}]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.JSONEq(t, `[
+			{"data":{"name":"test"}},
+			{"errors":[{"message":"Cannot query field \"missing\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null},
+			{"data":{"name":"test"}}
+		]`, resp.Body.String())
+	})
+
+	t.Run("operations that can not be decoded", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`[{"query":"{ name }"},{"query":1}]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `[
+			{"data":{"name":"test"}},
+			{"errors":[{"message":"json request body could not be decoded: json: cannot unmarshal number into Go struct field RawParams.query of type string"}],"data":null}
+		]`, resp.Body.String())
+	})
+
+	t.Run("operations using @defer", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`[{"query":"{ name }"},{"query":"query { ... @defer { name } }"},`+
+				`{"query":"query($d: Boolean!) { ...F } fragment F on Query { ... @defer(if: $d) { name } }","variables":{"d":true}}]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `[
+			{"data":{"name":"test"}},
+			{"errors":[{"message":"@defer and @stream are not supported in batched operations"}],"data":null},
+			{"errors":[{"message":"@defer and @stream are not supported in batched operations"}],"data":null}
+		]`, resp.Body.String())
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `[]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t,
+			`{"errors":[{"message":"batch must contain at least one operation"}],"data":null}`,
+			resp.Body.String())
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql",
+			`[{"query":"{ name }"},{"query":"{ name }"},{"query":"{ name }"},{"query":"{ name }"}]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t,
+			`{"errors":[{"message":"batch of 4 operations exceeds the limit of 3"}],"data":null}`,
+			resp.Body.String())
+	})
+
+	t.Run("single operations", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("batches need batching", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+		resp := doRequest(h, http.MethodPost, "/graphql", `[{"query":"{ name }"}]`,
+			"application/json", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func TestPOSTBatchingComplexityBudget(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{Batching: &transport.Batching{ComplexityBudget: 5}})
+	h.Use(extension.FixedComplexityLimit(10))
+	h.SetCalculatedComplexity(2)
+
+	resp := doRequest(h, http.MethodPost, "/graphql",
+		`[{"query":"{ name }"},{"query":"{ name }"},{"query":"{ name }"}]`,
+		"application/json", "application/json")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[
+		{"data":{"name":"test"}},
+		{"data":{"name":"test"}},
+		{"errors":[{"message":"operation has complexity 2, which exceeds the remaining complexity budget of 1","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}
+	]`, resp.Body.String())
+}
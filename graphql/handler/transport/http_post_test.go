@@ -1,6 +1,7 @@
 package transport_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -8,7 +9,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
@@ -339,6 +346,64 @@ func TestPOST(t *testing.T) {
 	})
 }
 
+func TestPOSTErrorRegistry(t *testing.T) {
+	data := `null`
+	newServer := func() *handler.Server {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `type Query { me: String! }`})
+		return handler.New(&graphql.ExecutableSchemaMock{
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				err := gqlerror.Errorf("not signed in")
+				errcode.Set(err, "UNAUTHENTICATED")
+				return graphql.OneShot(&graphql.Response{
+					Data:   []byte(data),
+					Errors: gqlerror.List{err},
+				})
+			},
+			SchemaFunc: func() *ast.Schema {
+				return schema
+			},
+		})
+	}
+
+	h := newServer()
+	h.AddTransport(transport.POST{})
+	h.ErrorRegistry().Register("UNAUTHENTICATED", errcode.ErrorType{Status: http.StatusUnauthorized})
+	h.ErrorRegistry().Register(errcode.ValidationFailed, errcode.ErrorType{
+		Kind:   errcode.KindProtocol,
+		Status: http.StatusBadRequest,
+	})
+
+	other := newServer()
+	other.AddTransport(transport.POST{})
+
+	t.Run("execution errors", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ me }"}`, "", "application/json")
+		assert.Equal(t, http.StatusUnauthorized, resp.Code, resp.Body.String())
+
+		resp = doRequest(other, http.MethodPost, "/graphql", `{"query":"{ me }"}`, "",
+			"application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, "registries are per server")
+	})
+
+	t.Run("partial data", func(t *testing.T) {
+		data = `{"me":null}`
+		defer func() { data = `null` }()
+
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ me }"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	})
+
+	t.Run("request errors", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ you }"}`, "",
+			"application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+
+		resp = doRequest(other, http.MethodPost, "/graphql", `{"query":"{ you }"}`, "",
+			"application/json")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+	})
+}
+
 func doRequest(
 	handler http.Handler,
 	method, target, body, accept, contentType string,
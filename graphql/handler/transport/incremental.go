@@ -0,0 +1,46 @@
+package transport
+
+import (
+	"slices"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// incrementalPayload is a payload following the initial response of an operation using @defer
+// or @stream, in the format of the incremental delivery spec:
+//
+//	{"incremental":[{"data":{"name":"a"},"path":["user"],"hasNext":true}],"hasNext":true}
+//
+// https://github.com/graphql/graphql-over-http/blob/main/rfcs/IncrementalDelivery.md
+type incrementalPayload struct {
+	Incremental []*graphql.Response `json:"incremental,omitempty"`
+	HasNext     bool                `json:"hasNext"`
+}
+
+// newIncrementalPayload wraps responses following the initial one. The response ending a
+// @stream only carries hasNext, so it is left out of the incremental responses.
+func newIncrementalPayload(responses []*graphql.Response) incrementalPayload {
+	last := responses[len(responses)-1]
+	return incrementalPayload{
+		Incremental: slices.DeleteFunc(responses, func(resp *graphql.Response) bool {
+			return resp.Data == nil && resp.Items == nil && len(resp.Errors) == 0
+		}),
+		HasNext: last.HasNext != nil && *last.HasNext,
+	}
+}
+
+// incrementalDelivery formats the responses of an operation sent one message each, as by the SSE
+// and websocket transports. The responses following an initial response with hasNext are
+// deferred fragments or streamed items, and are wrapped in incremental payloads.
+type incrementalDelivery struct {
+	incremental bool
+}
+
+// payload returns the payload of the next response of the operation.
+func (d *incrementalDelivery) payload(response *graphql.Response) any {
+	if d.incremental {
+		return newIncrementalPayload([]*graphql.Response{response})
+	}
+	d.incremental = response.HasNext != nil && *response.HasNext
+	return response
+}
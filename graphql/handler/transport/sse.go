@@ -22,6 +22,9 @@ type (
 		KeepAlivePingInterval time.Duration
 		// MinEventInterval optionally paces SSE event frames for high-throughput streams.
 		MinEventInterval time.Duration
+		// SingleConnection optionally enables the single connection mode of the graphql-sse
+		// protocol, besides its distinct connections mode.
+		SingleConnection *SSESingleConnection
 	}
 
 	sseConnection struct {
@@ -35,6 +38,9 @@ type (
 var _ graphql.Transport = SSE{}
 
 func (t SSE) Supports(r *http.Request) bool {
+	if t.SingleConnection != nil && t.SingleConnection.supports(r) {
+		return true
+	}
 	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
 		return false
 	}
@@ -46,7 +52,15 @@ func (t SSE) Supports(r *http.Request) bool {
 }
 
 func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	if t.SingleConnection != nil && t.SingleConnection.supports(r) {
+		t.SingleConnection.do(t, w, r, exec)
+		return
+	}
+
 	ctx := r.Context()
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		ctx = context.WithValue(ctx, lastEventID, id)
+	}
 	flusher, ok := w.(http.Flusher)
 	if !ok {
 		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
@@ -128,20 +142,21 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	if opErr != nil {
 		resp := exec.DispatchError(ctx, opErr)
 		if !writeEvent(ctx, func(w io.Writer) {
-			writeJsonWithSSE(w, resp)
+			writeJsonWithSSE(w, "", resp)
 		}) {
 			return
 		}
 	} else {
 		responses, dispatchCtx := exec.DispatchOperation(ctx, rc)
 		eventCtx = dispatchCtx
+		var delivery incrementalDelivery
 		for {
 			response := responses(dispatchCtx)
 			if response == nil {
 				break
 			}
 			if !writeEvent(dispatchCtx, func(w io.Writer) {
-				writeJsonWithSSE(w, response)
+				writeJsonWithSSE(w, response.EventID, delivery.payload(response))
 			}) {
 				return
 			}
@@ -215,10 +230,26 @@ func (c *sseConnection) writeAndFlush(w io.Writer, write func(io.Writer)) {
 	c.mu.Unlock()
 }
 
-func writeJsonWithSSE(w io.Writer, response *graphql.Response) {
-	b, err := json.Marshal(response)
+// writeJsonWithSSE writes a next event with payload. Its id is left out if it would break the
+// event in several lines.
+func writeJsonWithSSE(w io.Writer, id string, payload any) {
+	b, err := json.Marshal(payload)
 	if err != nil {
 		panic(err)
 	}
+	if id != "" && !strings.ContainsAny(id, "\r\n\x00") {
+		fmt.Fprintf(w, "event: next\nid: %s\ndata: %s\n\n", id, b)
+		return
+	}
 	fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
 }
+
+const lastEventID key = "sse_last_event_id_context"
+
+// GetLastEventID returns the Last-Event-ID header of an SSE request, which holds the id of the
+// last event received by a client resuming a subscription, as set by the resolver in
+// graphql.Event.ID. It returns an empty string for the other requests.
+func GetLastEventID(ctx context.Context) string {
+	id, _ := ctx.Value(lastEventID).(string)
+	return id
+}
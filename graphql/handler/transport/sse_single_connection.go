@@ -0,0 +1,495 @@
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SSEStreamTokenHeader is the header holding the token of the event stream of requests in the
+// single connection mode of SSE. The token query parameter can be used instead.
+const SSEStreamTokenHeader = "X-GraphQL-Event-Stream-Token"
+
+// defaultSSEMaxStreams is the number of event streams the default store of SSESingleConnection
+// reserves at most, as any client can reserve them.
+const defaultSSEMaxStreams = 1000
+
+// ErrSSEStreamLimit is returned by SSEStreamStore.Reserve when no more event streams can be
+// reserved.
+var ErrSSEStreamLimit = errors.New("too many event streams")
+
+// SSESingleConnection enables the single connection mode of the graphql-sse protocol, in which the
+// operations of a client share a single event stream, rather than opening a connection each:
+// https://github.com/enisdenjo/graphql-sse/blob/master/PROTOCOL.md#single-connection-mode
+//
+//   - A PUT request reserves an event stream, and gets its token in the response body.
+//   - A GET request accepting text/event-stream opens the event stream of its token.
+//   - A POST request starts an operation, identified by its operationId extension. Its responses
+//     are sent on the event stream as next events, followed by a complete event.
+//   - A DELETE request stops the operation of its operationId query parameter.
+//
+// The token of the stream is sent in the SSEStreamTokenHeader header, or the token query
+// parameter, of the GET, POST and DELETE requests. The stream, and its operations, end once its
+// GET request does.
+type SSESingleConnection struct {
+	// Store holds the reserved event streams. It defaults to an in-memory store of at most 1000
+	// streams. As reservations are not authenticated, set a store with a limit suiting the
+	// number of clients.
+	Store SSEStreamStore
+	// ReservationTimeout is the time a reserved event stream waits for its GET request before it
+	// is released. It defaults to one minute.
+	ReservationTimeout time.Duration
+	// MaxOperations limits the number of operations running at once on an event stream. There is
+	// no limit when it is zero.
+	MaxOperations int
+
+	once         sync.Once
+	defaultStore SSEStreamStore
+}
+
+// SSEStreamStore holds the event streams reserved in the single connection mode of SSE by their
+// token. Implementations must be safe for concurrent use.
+type SSEStreamStore interface {
+	// Reserve stores stream under a new token, which it returns. It returns ErrSSEStreamLimit
+	// when no more streams can be reserved.
+	Reserve(stream *SSEStream) (token string, err error)
+	// Get returns the stream reserved with token, or nil when there is none.
+	Get(token string) *SSEStream
+	// Release removes the stream reserved with token.
+	Release(token string)
+}
+
+// SSEStream is an event stream of the single connection mode of SSE, with the operations sending
+// their events on it.
+type SSEStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	events chan sseEvent
+
+	mu         sync.Mutex
+	connected  bool
+	expiry     *time.Timer
+	operations map[string]context.CancelFunc
+}
+
+type sseEvent struct {
+	name string
+	data []byte
+}
+
+// sseOperationEvent is the data of the events of an operation. Complete events have no payload.
+type sseOperationEvent struct {
+	ID      string `json:"id"`
+	Payload any    `json:"payload,omitempty"`
+}
+
+// NewSSEMemoryStore returns an SSEStreamStore keeping the event streams in memory, with at most
+// maxStreams of them reserved at once, or any number of them when maxStreams is zero.
+func NewSSEMemoryStore(maxStreams int) SSEStreamStore {
+	return &sseMemoryStore{
+		maxStreams: maxStreams,
+		streams:    map[string]*SSEStream{},
+	}
+}
+
+type sseMemoryStore struct {
+	mu         sync.Mutex
+	maxStreams int
+	streams    map[string]*SSEStream
+}
+
+func (s *sseMemoryStore) Reserve(stream *SSEStream) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maxStreams > 0 && len(s.streams) >= s.maxStreams {
+		return "", ErrSSEStreamLimit
+	}
+	token := rand.Text()
+	s.streams[token] = stream
+	return token, nil
+}
+
+func (s *sseMemoryStore) Get(token string) *SSEStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.streams[token]
+}
+
+func (s *sseMemoryStore) Release(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.streams, token)
+}
+
+func (s *SSESingleConnection) store() SSEStreamStore {
+	if s.Store != nil {
+		return s.Store
+	}
+	s.once.Do(func() {
+		s.defaultStore = NewSSEMemoryStore(defaultSSEMaxStreams)
+	})
+	return s.defaultStore
+}
+
+func (s *SSESingleConnection) supports(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPut:
+		return true
+	case http.MethodGet:
+		return sseStreamToken(r) != "" &&
+			strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+	case http.MethodPost:
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		return err == nil && sseStreamToken(r) != "" && mediaType == "application/json"
+	case http.MethodDelete:
+		return sseStreamToken(r) != ""
+	}
+	return false
+}
+
+func sseStreamToken(r *http.Request) string {
+	if token := r.Header.Get(SSEStreamTokenHeader); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("token")
+}
+
+func (s *SSESingleConnection) do(
+	t SSE,
+	w http.ResponseWriter,
+	r *http.Request,
+	exec graphql.GraphExecutor,
+) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == http.MethodPut {
+		s.reserve(w)
+		return
+	}
+
+	stream := s.store().Get(sseStreamToken(r))
+	if stream == nil {
+		SendErrorf(w, http.StatusNotFound, "event stream not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.connect(t, w, r, stream)
+	case http.MethodPost:
+		s.startOperation(w, r, exec, stream)
+	case http.MethodDelete:
+		id := r.URL.Query().Get("operationId")
+		if id == "" {
+			SendErrorf(w, http.StatusBadRequest, "operationId query parameter is required")
+			return
+		}
+		stream.stopOperation(id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// reserve reserves a new event stream, released if its GET request does not come in time.
+func (s *SSESingleConnection) reserve(w http.ResponseWriter) {
+	timeout := s.ReservationTimeout
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &SSEStream{
+		ctx:        ctx,
+		cancel:     cancel,
+		events:     make(chan sseEvent),
+		operations: map[string]context.CancelFunc{},
+	}
+
+	// The stream is locked until its expiry is set, so that it cannot be connected before.
+	stream.mu.Lock()
+	token, err := s.store().Reserve(stream)
+	if err == nil {
+		stream.expiry = time.AfterFunc(timeout, func() {
+			if stream.expire() {
+				s.store().Release(token)
+			}
+		})
+	}
+	stream.mu.Unlock()
+
+	if errors.Is(err, ErrSSEStreamLimit) {
+		SendErrorf(w, http.StatusServiceUnavailable, "%s", err)
+		return
+	}
+	if err != nil {
+		SendErrorf(w, http.StatusInternalServerError, "could not reserve event stream: %s", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+	io.WriteString(w, token)
+}
+
+// connect sends the events of stream until the GET request is done.
+func (s *SSESingleConnection) connect(
+	t SSE,
+	w http.ResponseWriter,
+	r *http.Request,
+	stream *SSEStream,
+) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+	if !stream.connect() {
+		SendErrorf(w, http.StatusConflict, "event stream already open")
+		return
+	}
+	defer func() {
+		stream.close()
+		s.store().Release(sseStreamToken(r))
+	}()
+
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Content-Type", "text/event-stream")
+	fmt.Fprint(w, ":\n\n")
+	flusher.Flush()
+
+	var keepAlive <-chan time.Time
+	if t.KeepAlivePingInterval > 0 {
+		ticker := time.NewTicker(t.KeepAlivePingInterval)
+		defer ticker.Stop()
+		keepAlive = ticker.C
+	}
+
+	ctx := r.Context()
+	lastEventSent := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stream.ctx.Done():
+			return
+		case <-keepAlive:
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+		case event := <-stream.events:
+			if !waitForMinEventInterval(ctx, lastEventSent, t.MinEventInterval) {
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.name, event.data)
+			flusher.Flush()
+			lastEventSent = time.Now()
+		}
+	}
+}
+
+// startOperation starts the operation of the POST request, whose responses are sent on stream.
+func (s *SSESingleConnection) startOperation(
+	w http.ResponseWriter,
+	r *http.Request,
+	exec graphql.GraphExecutor,
+	stream *SSEStream,
+) {
+	// The operation outlives its request, but keeps the values of its context.
+	ctx := context.WithoutCancel(r.Context())
+	params := &graphql.RawParams{}
+	start := graphql.Now()
+
+	bodyString, err := getRequestBody(r)
+	if err != nil {
+		gqlErr := gqlerror.Errorf("could not get json request body: %+v", err)
+		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+		log.Printf("could not get json request body: %+v", err.Error())
+		writeJson(w, resp)
+		return
+	}
+
+	if err = jsonDecode(strings.NewReader(bodyString), params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf(
+			"json request body could not be decoded: %+v body:%s",
+			err,
+			bodyString,
+		)
+		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+		log.Printf("decoding error: %+v body:%s", err.Error(), bodyString)
+		writeJson(w, resp)
+		return
+	}
+
+	id, _ := params.Extensions["operationId"].(string)
+	if id == "" {
+		SendErrorf(w, http.StatusBadRequest, "operationId extension is required")
+		return
+	}
+
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = graphql.WithOperationContext(ctx, rc)
+	if opErr != nil {
+		w.WriteHeader(statusFor(exec, opErr))
+		resp := exec.DispatchError(ctx, opErr)
+		writeJson(w, resp)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	switch err := stream.addOperation(id, cancel, s.MaxOperations); {
+	case errors.Is(err, errSSEOperationExists):
+		cancel()
+		SendErrorf(w, http.StatusConflict, "%s", err)
+		return
+	case err != nil:
+		cancel()
+		SendErrorf(w, http.StatusTooManyRequests, "%s", err)
+		return
+	}
+
+	go stream.run(ctx, cancel, id, exec, rc)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+var errSSEOperationExists = errors.New("operation already exists")
+
+func (s *SSEStream) connect() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected || s.ctx.Err() != nil {
+		return false
+	}
+	s.connected = true
+	s.expiry.Stop()
+	return true
+}
+
+// expire ends the stream if it was not connected, and reports whether it did.
+func (s *SSEStream) expire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected {
+		return false
+	}
+	s.cancel()
+	return true
+}
+
+// close ends the stream, stopping its operations.
+func (s *SSEStream) close() {
+	s.cancel()
+}
+
+func (s *SSEStream) addOperation(id string, cancel context.CancelFunc, limit int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.operations[id]; ok {
+		return errSSEOperationExists
+	}
+	if limit > 0 && len(s.operations) >= limit {
+		return fmt.Errorf("too many operations, at most %d can run at once", limit)
+	}
+	s.operations[id] = cancel
+	return nil
+}
+
+func (s *SSEStream) stopOperation(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.operations[id]; ok {
+		cancel()
+	}
+}
+
+func (s *SSEStream) removeOperation(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.operations, id)
+}
+
+// run sends the responses of the operation id on the stream, until the operation or the stream
+// ends.
+func (s *SSEStream) run(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	id string,
+	exec graphql.GraphExecutor,
+	rc *graphql.OperationContext,
+) {
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer func() {
+		if r := recover(); r != nil {
+			err := rc.Recover(ctx, r)
+			var gqlErr *gqlerror.Error
+			if !errors.As(err, &gqlErr) {
+				gqlErr = &gqlerror.Error{}
+				if err != nil {
+					gqlErr.Message = err.Error()
+				}
+			}
+			s.send("next", sseOperationEvent{
+				ID:      id,
+				Payload: &graphql.Response{Errors: gqlerror.List{gqlErr}},
+			})
+		}
+		s.send("complete", sseOperationEvent{ID: id})
+
+		stop()
+		s.removeOperation(id)
+		cancel()
+	}()
+
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	var delivery incrementalDelivery
+	for {
+		response := responses(ctx)
+		if response == nil {
+			return
+		}
+		if !s.send("next", sseOperationEvent{ID: id, Payload: delivery.payload(response)}) {
+			return
+		}
+	}
+}
+
+// send sends an event on the stream, waiting for it to be connected. It reports whether the
+// event was sent before the stream ended. A payload that can not be marshaled is replaced with an
+// error response.
+func (s *SSEStream) send(name string, data sseOperationEvent) bool {
+	b, err := json.Marshal(data)
+	if err != nil {
+		gqlErr := gqlerror.Errorf("unable to marshal response: %s", err)
+		b, err = json.Marshal(sseOperationEvent{
+			ID:      data.ID,
+			Payload: &graphql.Response{Errors: gqlerror.List{gqlErr}},
+		})
+		if err != nil {
+			return false
+		}
+	}
+	select {
+	case s.events <- sseEvent{name: name, data: b}:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,236 @@
+package transport_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSSESingleConnection(t *testing.T) {
+	initialize := func(sc *transport.SSESingleConnection) (*testserver.TestServer, *httptest.Server) {
+		h := testserver.New()
+		h.AddTransport(transport.SSE{SingleConnection: sc})
+		h.AddTransport(transport.POST{})
+		return h, httptest.NewServer(h)
+	}
+
+	do := func(t *testing.T, method, url, token, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set(transport.SSEStreamTokenHeader, token)
+		}
+		switch method {
+		case http.MethodGet:
+			req.Header.Set("Accept", "text/event-stream")
+		case http.MethodPost:
+			req.Header.Set("Content-Type", "application/json")
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	reserve := func(t *testing.T, url string) string {
+		t.Helper()
+		res := do(t, http.MethodPut, url, "", "")
+		defer res.Body.Close()
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		assert.Equal(t, "text/plain; charset=utf-8", res.Header.Get("Content-Type"))
+		token, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+		return string(token)
+	}
+
+	status := func(t *testing.T, method, url, token, body string) int {
+		t.Helper()
+		res := do(t, method, url, token, body)
+		require.NoError(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	readLine := func(br *bufio.Reader) string {
+		bs, err := br.ReadString('\n')
+		require.NoError(t, err)
+		return bs
+	}
+
+	readEvent := func(t *testing.T, br *bufio.Reader) (string, string) {
+		t.Helper()
+		event := strings.TrimPrefix(readLine(br), "event: ")
+		data := strings.TrimPrefix(readLine(br), "data: ")
+		assert.Equal(t, "\n", readLine(br))
+		return strings.TrimSuffix(event, "\n"), strings.TrimSuffix(data, "\n")
+	}
+
+	t.Run("operations share the event stream", func(t *testing.T) {
+		h, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodGet, srv.URL, token, "")
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		br := bufio.NewReader(res.Body)
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		require.Equal(t, http.StatusAccepted, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"subscription { name }","extensions":{"operationId":"sub"}}`,
+		))
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			h.SendNextSubscriptionMessage()
+		})
+		event, data := readEvent(t, br)
+		assert.Equal(t, "next", event)
+		assert.JSONEq(t, `{"id":"sub","payload":{"data":{"name":"test"}}}`, data)
+		wg.Wait()
+
+		require.Equal(t, http.StatusAccepted, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"{ name }","extensions":{"operationId":"query"}}`,
+		))
+		event, data = readEvent(t, br)
+		assert.Equal(t, "next", event)
+		assert.JSONEq(t, `{"id":"query","payload":{"data":{"name":"test"}}}`, data)
+		event, data = readEvent(t, br)
+		assert.Equal(t, "complete", event)
+		assert.JSONEq(t, `{"id":"query"}`, data)
+
+		require.Equal(
+			t,
+			http.StatusOK,
+			status(t, http.MethodDelete, srv.URL+"?operationId=sub", token, ""),
+		)
+		event, data = readEvent(t, br)
+		assert.Equal(t, "complete", event)
+		assert.JSONEq(t, `{"id":"sub"}`, data)
+	})
+
+	t.Run("token in the query string", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodGet, srv.URL+"?token="+token, "", "")
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{MaxOperations: 1})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodGet, srv.URL, token, "")
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		assert.Equal(t, http.StatusNotFound, status(t, http.MethodGet, srv.URL, "unknown", ""))
+		assert.Equal(t, http.StatusConflict, status(t, http.MethodGet, srv.URL, token, ""))
+		assert.Equal(t, http.StatusBadRequest, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"subscription { name }"}`,
+		))
+		assert.Equal(t, http.StatusUnprocessableEntity, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"subscription { title }","extensions":{"operationId":"a"}}`,
+		))
+		assert.Equal(t, http.StatusBadRequest, status(t, http.MethodDelete, srv.URL, token, ""))
+
+		sub := `{"query":"subscription { name }","extensions":{"operationId":"a"}}`
+		assert.Equal(t, http.StatusAccepted, status(t, http.MethodPost, srv.URL, token, sub))
+		assert.Equal(t, http.StatusConflict, status(t, http.MethodPost, srv.URL, token, sub))
+		assert.Equal(t, http.StatusTooManyRequests, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"subscription { name }","extensions":{"operationId":"b"}}`,
+		))
+	})
+
+	t.Run("sends an error for responses that can not be marshaled", func(t *testing.T) {
+		h, srv := initialize(&transport.SSESingleConnection{})
+		defer srv.Close()
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			if resp != nil {
+				resp.Data = []byte(`{`)
+			}
+			return resp
+		})
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodGet, srv.URL, token, "")
+		defer res.Body.Close()
+		br := bufio.NewReader(res.Body)
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		require.Equal(t, http.StatusAccepted, status(t, http.MethodPost, srv.URL, token,
+			`{"query":"{ name }","extensions":{"operationId":"query"}}`,
+		))
+		event, data := readEvent(t, br)
+		assert.Equal(t, "next", event)
+		assert.Contains(t, data, `"id":"query"`)
+		assert.Contains(t, data, "unable to marshal response")
+		event, data = readEvent(t, br)
+		assert.Equal(t, "complete", event)
+		assert.JSONEq(t, `{"id":"query"}`, data)
+	})
+
+	t.Run("limits the reserved streams", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{
+			Store: transport.NewSSEMemoryStore(1),
+		})
+		defer srv.Close()
+
+		reserve(t, srv.URL)
+		assert.Equal(t, http.StatusServiceUnavailable, status(t, http.MethodPut, srv.URL, "", ""))
+	})
+
+	t.Run("releases expired reservations", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{
+			Store:              transport.NewSSEMemoryStore(1),
+			ReservationTimeout: time.Millisecond,
+		})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		require.Eventually(t, func() bool {
+			return status(t, http.MethodGet, srv.URL, token, "") == http.StatusNotFound
+		}, time.Second, time.Millisecond)
+		reserve(t, srv.URL)
+	})
+
+	t.Run("releases closed streams", func(t *testing.T) {
+		_, srv := initialize(&transport.SSESingleConnection{
+			Store: transport.NewSSEMemoryStore(1),
+		})
+		defer srv.Close()
+
+		token := reserve(t, srv.URL)
+		res := do(t, http.MethodGet, srv.URL, token, "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, res.Body.Close())
+
+		require.Eventually(t, func() bool {
+			return status(t, http.MethodPost, srv.URL, token,
+				`{"query":"{ name }","extensions":{"operationId":"a"}}`,
+			) == http.StatusNotFound
+		}, time.Second, time.Millisecond)
+		reserve(t, srv.URL)
+	})
+}
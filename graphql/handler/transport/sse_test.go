@@ -198,6 +198,53 @@ func TestSSE(t *testing.T) {
 		wg.Wait()
 	})
 
+	t.Run("deferred", func(t *testing.T) {
+		handler, srv := initializeWithServer()
+		defer srv.Close()
+
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			handler.SendNextSubscriptionMessage()
+		})
+
+		client := &http.Client{}
+		req := createHTTPRequest(srv.URL, `{"query":"query { ... @defer { name } }"}`)
+		res, err := client.Do(req)
+		require.NoError(t, err, "Request threw error -> %s", err)
+		defer func() {
+			require.NoError(t, res.Body.Close())
+		}()
+
+		br := bufio.NewReader(res.Body)
+
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+		assert.Equal(t, "event: next\n", readLine(br))
+		assert.Equal(t, "data: {\"data\":{\"name\":null},\"hasNext\":true}\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		wg.Go(func() {
+			handler.SendNextSubscriptionMessage()
+		})
+
+		assert.Equal(t, "event: next\n", readLine(br))
+		assert.Equal(
+			t,
+			"data: {\"incremental\":[{\"data\":{\"name\":\"test\"},\"hasNext\":false}],\"hasNext\":false}\n",
+			readLine(br),
+		)
+		assert.Equal(t, "\n", readLine(br))
+
+		wg.Go(func() {
+			handler.SendCompleteSubscriptionMessage()
+		})
+
+		assert.Equal(t, "event: complete\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		wg.Wait()
+	})
+
 	t.Run("subscribe with keep alive", func(t *testing.T) {
 		handler, srv := initializeKeepAliveWithServer()
 		defer srv.Close()
@@ -270,10 +317,33 @@ func TestSSE(t *testing.T) {
 		assert.Contains(t, body, `data: {"data":{"name":"test3"}}`)
 		assert.Contains(t, body, "event: complete\n")
 	})
+
+	t.Run("event ids and Last-Event-ID", func(t *testing.T) {
+		exec := &sseGraphExecutor{responses: []*graphql.Response{
+			{Data: []byte(`{"name":"test1"}`), EventID: "1"},
+			{Data: []byte(`{"name":"test2"}`), EventID: "2\n"},
+		}}
+		req := createHTTPTestRequest(`{"query":"subscription { name }"}`)
+		req.Header.Set("Last-Event-ID", "0")
+		w := httptest.NewRecorder()
+		transport.SSE{}.Do(w, req, exec)
+
+		assert.Equal(t, "0", exec.lastEventID)
+		br := bufio.NewReader(w.Body)
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+		assert.Equal(t, "event: next\n", readLine(br))
+		assert.Equal(t, "id: 1\n", readLine(br))
+		assert.Equal(t, `data: {"data":{"name":"test1"}}`+"\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+		assert.Equal(t, "event: next\n", readLine(br), "ids with line breaks are left out")
+		assert.Equal(t, `data: {"data":{"name":"test2"}}`+"\n", readLine(br))
+	})
 }
 
 type sseGraphExecutor struct {
-	responses []*graphql.Response
+	responses   []*graphql.Response
+	lastEventID string
 }
 
 func (e *sseGraphExecutor) CreateOperationContext(
@@ -287,6 +357,7 @@ func (e *sseGraphExecutor) DispatchOperation(
 	ctx context.Context,
 	_ *graphql.OperationContext,
 ) (graphql.ResponseHandler, context.Context) {
+	e.lastEventID = transport.GetLastEventID(ctx)
 	index := 0
 	return func(context.Context) *graphql.Response {
 		if index >= len(e.responses) {
@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
 )
 
 func writeJson(w io.Writer, response *graphql.Response) {
@@ -18,6 +20,36 @@ func writeJson(w io.Writer, response *graphql.Response) {
 	w.Write(b)
 }
 
+// writeJsonResponse writes the response of an operation. When the response has no data, as when
+// an error propagated up to the root, it is sent with the HTTP status registered for the code of
+// its errors, if any. Responses with partial data keep the 200 status, so that clients and
+// proxies do not discard the data.
+func writeJsonResponse(
+	w http.ResponseWriter,
+	exec graphql.GraphExecutor,
+	response *graphql.Response,
+) {
+	if response != nil && isNullData(response.Data) {
+		if status := errorRegistry(exec).Status(response.Errors); status != 0 {
+			w.WriteHeader(status)
+		}
+	}
+	writeJson(w, response)
+}
+
+func isNullData(data json.RawMessage) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+// errorRegistry returns the error registry of exec. Executors without one classify errors by the
+// types registered with the deprecated errcode.RegisterErrorType.
+func errorRegistry(exec graphql.GraphExecutor) *errcode.Registry {
+	if e, ok := exec.(interface{ ErrorRegistry() *errcode.Registry }); ok {
+		return e.ErrorRegistry()
+	}
+	return nil
+}
+
 func writeJsonError(w io.Writer, msg string) {
 	writeJson(w, &graphql.Response{Errors: gqlerror.List{{Message: msg}}})
 }
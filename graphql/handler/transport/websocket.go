@@ -449,7 +449,7 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 	rc, err := c.exec.CreateOperationContext(ctx, params)
 	if err != nil {
 		resp := c.exec.DispatchError(graphql.WithOperationContext(ctx, rc), err)
-		switch errcode.GetErrorKind(err) {
+		switch errorRegistry(c.exec).Kind(err) {
 		case errcode.KindProtocol:
 			c.sendError(msg.id, resp.Errors...)
 		default:
@@ -497,21 +497,22 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 		}()
 
 		responses, ctx := c.exec.DispatchOperation(ctx, rc)
+		var delivery incrementalDelivery
 		for {
 			response := responses(ctx)
 			if response == nil {
 				break
 			}
 
-			c.sendResponse(msg.id, response)
+			c.sendResponse(msg.id, delivery.payload(response))
 		}
 
 		// complete and context cancel comes from the defer
 	}()
 }
 
-func (c *wsConnection) sendResponse(id string, response *graphql.Response) {
-	b, err := json.Marshal(response)
+func (c *wsConnection) sendResponse(id string, payload any) {
+	b, err := json.Marshal(payload)
 	if err != nil {
 		panic(err)
 	}
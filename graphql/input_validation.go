@@ -0,0 +1,84 @@
+package graphql
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// InputValidator is implemented by the input models generated with the
+// enable_model_input_validate option, whose Validate method checks that they satisfy the schema
+// and returns the violations as a gqlerror.List.
+type InputValidator interface {
+	Validate() error
+}
+
+// InputValidation collects the violations found by the generated Validate methods of input
+// models. Their paths are relative to the validated input, e.g. items[3].name is
+// ["items", 3, "name"], so they can be prefixed with the path of the input in a query.
+type InputValidation struct {
+	errs gqlerror.List
+}
+
+// Field checks value, the value of the input field name. The nonNull levels of its type must not
+// be null, where 0 is the field itself and 1 the items of a list, the value must satisfy rules,
+// and the input models in it are checked with their Validate method.
+func (v *InputValidation) Field(name string, value any, nonNull []int, rules ...ConstraintRule) {
+	path := ast.Path{ast.PathName(name)}
+	rv := reflect.ValueOf(value)
+	if len(rules) > 0 {
+		validateConstraint(path, rv, rules, true, &v.errs)
+	}
+	v.validateValue(path, rv, nonNull, 0)
+}
+
+// Err returns the violations found, as a gqlerror.List, or nil when there are none.
+func (v *InputValidation) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *InputValidation) validateValue(path ast.Path, rv reflect.Value, nonNull []int, level int) {
+	if isNilValue(rv) {
+		if slices.Contains(nonNull, level) {
+			v.errs = append(v.errs, validationError(path, "must not be null"))
+		}
+		return
+	}
+
+	if validator, ok := rv.Interface().(InputValidator); ok {
+		v.addNested(path, validator.Validate())
+		return
+	}
+
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Slice {
+		for i := range rv.Len() {
+			v.validateValue(appendPath(path, ast.PathIndex(i)), rv.Index(i), nonNull, level+1)
+		}
+	}
+}
+
+// addNested adds the violations of a nested input model at path.
+func (v *InputValidation) addNested(path ast.Path, err error) {
+	if err == nil {
+		return
+	}
+
+	var errs gqlerror.List
+	if !errors.As(err, &errs) {
+		v.errs = append(v.errs, gqlerror.WrapPath(path, err))
+		return
+	}
+	for _, nested := range errs {
+		nested.Path = append(slices.Clone(path), nested.Path...)
+		v.errs = append(v.errs, nested)
+	}
+}
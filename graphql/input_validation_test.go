@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+type testItemInput struct {
+	Name string
+}
+
+func (this testItemInput) Validate() error {
+	var v InputValidation
+	v.Field("name", this.Name, nil, MinLength(2))
+	return v.Err()
+}
+
+type testFailingInput struct{}
+
+func (testFailingInput) Validate() error {
+	return errors.New("failed")
+}
+
+func TestInputValidation(t *testing.T) {
+	name := "x"
+	tests := []struct {
+		name     string
+		field    string
+		value    any
+		nonNull  []int
+		rules    []ConstraintRule
+		expected []string
+	}{
+		{name: "valid value", field: "name", value: "xy", rules: []ConstraintRule{MinLength(2)}},
+		{
+			name:     "constraint violation",
+			field:    "name",
+			value:    &name,
+			rules:    []ConstraintRule{MinLength(2)},
+			expected: []string{"input: name must be at least 2 characters long"},
+		},
+		{name: "nullable null value", field: "name", value: (*string)(nil)},
+		{
+			name:     "non-null null value",
+			field:    "name",
+			value:    (*string)(nil),
+			nonNull:  []int{0},
+			rules:    []ConstraintRule{MinLength(2)},
+			expected: []string{"input: name must not be null"},
+		},
+		{
+			name:     "non-null list items",
+			field:    "items",
+			value:    []*testItemInput{{Name: "xy"}, nil},
+			nonNull:  []int{0, 1},
+			expected: []string{"input: items[1] must not be null"},
+		},
+		{
+			name:     "nested input",
+			field:    "items",
+			value:    []*testItemInput{{Name: "xy"}, {Name: "x"}},
+			expected: []string{"input: items[1].name must be at least 2 characters long"},
+		},
+		{
+			name:     "nested error",
+			field:    "input",
+			value:    testFailingInput{},
+			expected: []string{"input: input failed"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var v InputValidation
+			v.Field(tc.field, tc.value, tc.nonNull, tc.rules...)
+			err := v.Err()
+			if len(tc.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs gqlerror.List
+			require.ErrorAs(t, err, &errs)
+			messages := make([]string, 0, len(errs))
+			for _, e := range errs {
+				messages = append(messages, e.Error())
+			}
+			require.Equal(t, tc.expected, messages)
+		})
+	}
+}
+
+func TestInputValidationPaths(t *testing.T) {
+	var v InputValidation
+	v.Field("items", []testItemInput{{Name: "x"}}, nil)
+
+	var errs gqlerror.List
+	require.ErrorAs(t, v.Err(), &errs)
+	require.Len(t, errs, 1)
+	require.Equal(t, ast.Path{ast.PathName("items"), ast.PathIndex(0), ast.PathName("name")},
+		errs[0].Path)
+	require.Equal(t, errcode.ValidationFailed, errs[0].Extensions["code"])
+}
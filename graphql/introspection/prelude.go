@@ -0,0 +1,27 @@
+package introspection
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Prelude extends the introspection types of the gqlparser prelude with the proposed ones gqlgen
+// supports. Generated schemas include it alongside their own sources.
+var Prelude = &ast.Source{
+	Name: "gqlgen/introspection.graphql",
+	Input: `"The behavior of the executor when a field raises an error."
+enum __ErrorBehavior {
+  "The failing field is null, even when it is non-null."
+  NULL
+  "The null of a failing non-null field propagates to its closest nullable parent."
+  PROPAGATE
+  "The operation stops at the first error and its data is null."
+  HALT
+}
+
+extend type __Schema {
+  "The error behavior of requests that do not select one with onError."
+  defaultErrorBehavior: __ErrorBehavior!
+}
+`,
+	BuiltIn: true,
+}
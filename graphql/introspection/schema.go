@@ -7,7 +7,24 @@ import (
 )
 
 type Schema struct {
-	schema *ast.Schema
+	schema               *ast.Schema
+	defaultErrorBehavior string
+}
+
+// WithDefaultErrorBehavior returns a copy of s reporting behavior as its defaultErrorBehavior.
+func (s *Schema) WithDefaultErrorBehavior(behavior string) *Schema {
+	c := *s
+	c.defaultErrorBehavior = behavior
+	return &c
+}
+
+// DefaultErrorBehavior is the error behavior of requests that do not select one, PROPAGATE
+// unless the server sets another one.
+func (s *Schema) DefaultErrorBehavior() string {
+	if s.defaultErrorBehavior == "" {
+		return "PROPAGATE"
+	}
+	return s.defaultErrorBehavior
 }
 
 func (s *Schema) Description() *string {
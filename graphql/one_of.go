@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// OneOfDirective is the name of the directive marking input objects of which exactly one field
+// must be given:
+//
+//	directive @oneOf on INPUT_OBJECT
+//
+// gqlgen generates such input objects as an interface with one implementation per field.
+const OneOfDirective = "oneOf"
+
+// ValidateOneOf returns a validation error unless obj, the value of the @oneOf input object
+// typeName, has exactly one key and its value is not null.
+//
+// Literal values are already checked by the validator; this also covers values given as
+// variables.
+func ValidateOneOf(ctx context.Context, typeName string, obj map[string]any) error {
+	if len(obj) != 1 {
+		return oneOfError(ctx, "OneOf Input Object %q must specify exactly one key.", typeName)
+	}
+	for k, v := range obj {
+		if v == nil {
+			return oneOfError(ctx, "Field \"%s.%s\" must be non-null.", typeName, k)
+		}
+	}
+	return nil
+}
+
+func oneOfError(ctx context.Context, message string, args ...any) error {
+	err := gqlerror.ErrorPathf(GetPath(ctx), message, args...)
+	errcode.Set(err, errcode.ValidationFailed)
+	return err
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+func TestValidateOneOf(t *testing.T) {
+	ctx := WithPathContext(context.Background(), NewPathWithField("pet"))
+
+	tests := []struct {
+		name    string
+		obj     map[string]any
+		message string
+	}{
+		{name: "one key", obj: map[string]any{"cat": "Tom"}},
+		{
+			name:    "no key",
+			obj:     map[string]any{},
+			message: `OneOf Input Object "PetInput" must specify exactly one key.`,
+		},
+		{
+			name:    "two keys",
+			obj:     map[string]any{"cat": "Tom", "dog": "Rex"},
+			message: `OneOf Input Object "PetInput" must specify exactly one key.`,
+		},
+		{
+			name:    "null value",
+			obj:     map[string]any{"dog": nil},
+			message: `Field "PetInput.dog" must be non-null.`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateOneOf(ctx, "PetInput", tc.obj)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var gqlErr *gqlerror.Error
+			require.ErrorAs(t, err, &gqlErr)
+			require.Equal(t, tc.message, gqlErr.Message)
+			require.Equal(t, ast.Path{ast.PathName("pet")}, gqlErr.Path)
+			require.Equal(t, errcode.ValidationFailed, gqlErr.Extensions["code"])
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"strconv"
+	"sync"
+)
+
+// ReplayBuffer keeps the last events published on a subscription in memory, so that subscribers
+// resuming from the ID of the last event they received, such as the Last-Event-ID of SSE
+// requests, can replay the events they missed. Published events get increasing IDs.
+//
+// To neither miss nor repeat events, publish the events to the buffer and to the subscribers, and
+// add resumed subscribers after replaying their events, while holding the same lock.
+//
+// A ReplayBuffer is safe for concurrent use.
+type ReplayBuffer[T any] struct {
+	mu     sync.Mutex
+	events []Event[T]
+	next   uint64
+}
+
+// NewReplayBuffer returns a ReplayBuffer keeping the last size events. It panics if size is not
+// positive.
+func NewReplayBuffer[T any](size int) *ReplayBuffer[T] {
+	if size <= 0 {
+		panic("graphql: size of ReplayBuffer must be positive")
+	}
+	return &ReplayBuffer[T]{events: make([]Event[T], 0, size)}
+}
+
+// Publish stores value as the next event, evicting the oldest one when the buffer is full, and
+// returns the event with its ID. Its Context is nil.
+func (b *ReplayBuffer[T]) Publish(value T) Event[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ev := Event[T]{Value: value, ID: strconv.FormatUint(b.next, 10)}
+	if len(b.events) < cap(b.events) {
+		b.events = append(b.events, ev)
+	} else {
+		b.events[b.next%uint64(cap(b.events))] = ev
+	}
+	b.next++
+	return ev
+}
+
+// Since returns the events published after the event lastID, oldest first. It reports false when
+// they cannot all be replayed, as when lastID was not published by the buffer or some of the
+// events following it were evicted.
+func (b *ReplayBuffer[T]) Since(lastID string) ([]Event[T], bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	last, err := strconv.ParseUint(lastID, 10, 64)
+	if err != nil || last >= b.next {
+		return nil, false
+	}
+	if oldest := b.next - uint64(len(b.events)); last+1 < oldest {
+		return nil, false
+	}
+
+	events := make([]Event[T], 0, b.next-last-1)
+	for n := last + 1; n < b.next; n++ {
+		events = append(events, b.events[n%uint64(cap(b.events))])
+	}
+	return events, true
+}
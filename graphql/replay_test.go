@@ -0,0 +1,42 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayBuffer(t *testing.T) {
+	b := NewReplayBuffer[string](2)
+
+	_, ok := b.Since("0")
+	require.False(t, ok, "nothing published yet")
+
+	require.Equal(t, Event[string]{Value: "a", ID: "0"}, b.Publish("a"))
+	events, ok := b.Since("0")
+	require.True(t, ok)
+	require.Empty(t, events)
+
+	b.Publish("b")
+	b.Publish("c")
+
+	events, ok = b.Since("1")
+	require.True(t, ok)
+	require.Equal(t, []Event[string]{{Value: "c", ID: "2"}}, events)
+
+	events, ok = b.Since("0")
+	require.True(t, ok, "the events following 0 are still buffered")
+	require.Equal(t, []Event[string]{{Value: "b", ID: "1"}, {Value: "c", ID: "2"}}, events)
+
+	b.Publish("d")
+	_, ok = b.Since("0")
+	require.False(t, ok, "b was evicted")
+
+	_, ok = b.Since("4")
+	require.False(t, ok, "not published yet")
+
+	_, ok = b.Since("cursor")
+	require.False(t, ok)
+
+	require.Panics(t, func() { NewReplayBuffer[string](0) })
+}
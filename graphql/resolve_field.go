@@ -3,6 +3,8 @@ package graphql
 import (
 	"context"
 	"io"
+	"iter"
+	"slices"
 
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -35,6 +37,104 @@ func ResolveField[T any](
 	)
 }
 
+// ResolveFieldList resolves a list field whose items can be streamed with @stream. Its resolver
+// returns a value of type S, a slice or a lazy source of items, whose items are listed by items.
+//
+// Without @stream, or outside of queries, the items are marshaled with marshal. With it, the
+// first items are, and incremental delivers the others, marshaled with marshalItem as they
+// resolve.
+func ResolveFieldList[S, E any](
+	ctx context.Context,
+	oc *OperationContext,
+	incremental StreamProcessor,
+	field CollectedField,
+	initializeFieldContext func(ctx context.Context, field CollectedField) (*FieldContext, error),
+	fieldResolver func(ctx context.Context) (any, error),
+	middlewareChain func(ctx context.Context, next Resolver) Resolver,
+	marshal func(ctx context.Context, sel ast.SelectionSet, v []E) Marshaler,
+	items func(res S) iter.Seq[E],
+	marshalItem func(ctx context.Context, sel ast.SelectionSet, v E) Marshaler,
+	recoverFromPanic bool,
+	nonNull bool,
+	itemNonNull bool,
+) Marshaler {
+	return resolveField[S, Marshaler](
+		ctx,
+		oc,
+		field,
+		initializeFieldContext,
+		fieldResolver,
+		middlewareChain,
+		recoverFromPanic,
+		nonNull,
+		Null,
+		RequiredNull,
+		func(ctx context.Context, res S) Marshaler {
+			if isNilItems[S, E](res) {
+				return marshal(ctx, field.Selections, nil)
+			}
+			stream := field.Stream
+			if stream == nil || oc.Operation == nil || oc.Operation.Operation != ast.Query {
+				return marshal(ctx, field.Selections, collectItems(res, items))
+			}
+			if stream.InitialCount < 0 {
+				oc.Errorf(ctx, "initialCount of @stream must not be negative")
+				return Null
+			}
+
+			next, stop := iter.Pull(items(res))
+			initial := make([]E, 0, stream.InitialCount)
+			for len(initial) < stream.InitialCount {
+				v, ok := next()
+				if !ok {
+					break
+				}
+				initial = append(initial, v)
+			}
+			ret := marshal(ctx, field.Selections, initial)
+			if ret == Null || len(initial) < stream.InitialCount {
+				stop()
+				return ret
+			}
+
+			incremental.ProcessStream(StreamGroup{
+				Path:    GetPath(ctx),
+				Label:   stream.Label,
+				Context: ctx,
+				Start:   len(initial),
+				Next: func(ctx context.Context, index int) (ret Marshaler, ok bool) {
+					fc := &FieldContext{Index: &index}
+					ctx = WithFieldContext(ctx, fc)
+					if recoverFromPanic {
+						defer func() {
+							if r := recover(); r != nil {
+								oc.Error(ctx, oc.Recover(ctx, r))
+								ret, ok = Null, true
+								stop()
+							}
+						}()
+					}
+
+					v, ok := next()
+					if !ok {
+						return nil, false
+					}
+					fc.Result = &v
+					item := marshalItem(ctx, field.Selections, v)
+					if item == Null && itemNonNull && oc.PropagatesNulls() {
+						// the null propagates to the list, which ends the stream
+						stop()
+						return Null, true
+					}
+					return Array{item}, true
+				},
+				Stop: stop,
+			})
+			return ret
+		},
+	)
+}
+
 func ResolveFieldStream[T any](
 	ctx context.Context,
 	oc *OperationContext,
@@ -85,7 +185,8 @@ func ResolveFieldStream[T any](
 // channel receive, but the channel element type is [Event][T] rather than T,
 // and the returned function emits both the per-event context (from
 // [Event].Context) and the marshaler. When [Event].Context is nil the input
-// context is passed through unchanged.
+// context is passed through unchanged. [Event].ID is carried by the per-event
+// context to the EventID of the event's [Response].
 func ResolveFieldStreamWithEventContext[T any](
 	ctx context.Context,
 	oc *OperationContext,
@@ -119,6 +220,9 @@ func ResolveFieldStreamWithEventContext[T any](
 					if eventCtx == nil {
 						eventCtx = ctx
 					}
+					if ev.ID != "" {
+						eventCtx = withEventID(eventCtx, ev.ID)
+					}
 					return eventCtx, WriterFunc(func(w io.Writer) {
 						w.Write([]byte{'{'})
 						MarshalString(field.Alias).MarshalGQL(w)
@@ -180,7 +284,7 @@ func resolveField[T, R any](
 		return defaultResult
 	}
 	if resTmp == nil {
-		if nonNull || fc.NonNull {
+		if nonNull || fc.NonNull || slices.Contains(fc.SemanticNonNullLevels, 0) {
 			if !HasFieldError(ctx, fc) {
 				oc.Errorf(ctx, "must not be null")
 			}
@@ -192,6 +296,9 @@ func resolveField[T, R any](
 	}
 	if res, ok := resTmp.(T); ok {
 		fc.Result = res
+		if len(fc.SemanticNonNullLevels) > 0 {
+			checkSemanticNonNull(ctx, fc, fc.SemanticNonNullLevels, res)
+		}
 		return result(ctx, res)
 	}
 	if res, ok := resTmp.(R); ok {
@@ -62,6 +62,25 @@ func TestResolveFieldStreamWithEventContext(t *testing.T) {
 		assertEventID(got, t, "input")
 	})
 
+	t.Run("carries Event.ID to the response", func(t *testing.T) {
+		ch := make(chan Event[string], 2)
+		ch <- Event[string]{Context: context.Background(), Value: "first", ID: "1"}
+		ch <- Event[string]{Context: context.Background(), Value: "second"}
+		close(ch)
+
+		responses := SubscriptionEventResponseHandler(
+			makeWithEventContextResolver(t, (<-chan Event[string])(ch)),
+		)
+		_, resp := responses(context.Background())
+		if resp == nil || resp.EventID != "1" {
+			t.Fatalf("expected a response for event 1, got %+v", resp)
+		}
+		_, resp = responses(context.Background())
+		if resp == nil || resp.EventID != "" {
+			t.Fatalf("expected a response without event id, got %+v", resp)
+		}
+	})
+
 	t.Run("returns nil when input context is cancelled and channel is empty", func(t *testing.T) {
 		ch := make(chan Event[string])
 		next := makeWithEventContextResolver(t, (<-chan Event[string])(ch))
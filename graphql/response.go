@@ -13,12 +13,31 @@ import (
 // https://github.com/facebook/graphql/commit/7b40390d48680b15cb93e02d46ac5eb249689876#diff-757cea6edf0288677a9eea4cfc801d87R107
 // and https://github.com/facebook/graphql/pull/384
 type Response struct {
-	Errors     gqlerror.List   `json:"errors,omitempty"`
-	Data       json.RawMessage `json:"data"`
+	Errors gqlerror.List   `json:"errors,omitempty"`
+	Data   json.RawMessage `json:"data"`
+	// Items are the items of a list streamed with @stream, sent instead of Data in incremental
+	// payloads.
+	Items      json.RawMessage `json:"items,omitempty"`
 	Label      string          `json:"label,omitempty"`
 	Path       ast.Path        `json:"path,omitempty"`
 	HasNext    *bool           `json:"hasNext,omitempty"`
 	Extensions map[string]any  `json:"extensions,omitempty"`
+	// EventID is the ID of the subscription event the response is for, as set in Event.ID. It is
+	// not part of the response, but sent along with it by the transports supporting it.
+	EventID string `json:"-"`
+}
+
+// MarshalJSON omits the data of the incremental payloads carrying streamed items, and of the
+// one ending a stream, which only carries hasNext.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type response Response
+	if r.Items == nil && (r.Data != nil || r.HasNext == nil) {
+		return json.Marshal(response(r))
+	}
+	return json.Marshal(struct {
+		response
+		Data json.RawMessage `json:"data,omitempty"`
+	}{response: response(r)})
 }
 
 func ErrorResponse(ctx context.Context, messagef string, args ...any) *Response {
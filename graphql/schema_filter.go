@@ -0,0 +1,292 @@
+package graphql
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// FilterSchema returns a copy of schema without the types, fields, arguments, input fields and
+// enum values for which visible returns false. visible receives the schema coordinate of each
+// element, such as "User", "User.email", "Query.users(role:)" or "Role.ADMIN", and its
+// directives. Fields and arguments using hidden types are hidden too, as are types left without
+// fields or values; built in types and the query type are never hidden.
+//
+// The result stays a valid schema: fields with a hidden required argument are hidden, input types
+// with a hidden required field are hidden, and fields and arguments of interfaces are hidden
+// when an implementation of the interface no longer has them.
+//
+// Definitions are copied when they change, schema itself is never modified.
+func FilterSchema(
+	schema *ast.Schema,
+	visible func(coordinate string, directives ast.DirectiveList) bool,
+) *ast.Schema {
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hidden := map[string]bool{}
+	for _, name := range names {
+		def := schema.Types[name]
+		if isAlwaysVisible(def) || def == schema.Query {
+			continue
+		}
+		if !visible(name, def.Directives) {
+			hidden[name] = true
+		}
+	}
+
+	// hiding a type hides the fields using it, which can leave other types empty or unusable, and
+	// interfaces lose the fields their implementations lost, so repeat until nothing changes
+	hiddenFields := map[string]bool{}
+	var types map[string]*ast.Definition
+	for changed := true; changed; {
+		changed = false
+		types = make(map[string]*ast.Definition, len(schema.Types))
+		for _, name := range names {
+			if hidden[name] {
+				continue
+			}
+			def := schema.Types[name]
+			if isAlwaysVisible(def) {
+				types[name] = def
+				continue
+			}
+			filtered, usable := filterDefinition(def, hidden, hiddenFields, visible)
+			if (!usable || isEmptyDefinition(filtered)) && def != schema.Query {
+				hidden[name] = true
+				changed = true
+				continue
+			}
+			types[name] = filtered
+		}
+		if !changed {
+			changed = hideUnimplementedFields(types, hiddenFields)
+		}
+	}
+
+	filtered := &ast.Schema{
+		Query:            types[nameOf(schema.Query)],
+		Mutation:         types[nameOf(schema.Mutation)],
+		Subscription:     types[nameOf(schema.Subscription)],
+		SchemaDirectives: schema.SchemaDirectives,
+		Types:            types,
+		Directives:       schema.Directives,
+		PossibleTypes:    map[string][]*ast.Definition{},
+		Implements:       map[string][]*ast.Definition{},
+		Description:      schema.Description,
+		Comment:          schema.Comment,
+	}
+
+	for _, name := range names {
+		def, ok := types[name]
+		if !ok {
+			continue
+		}
+		switch def.Kind {
+		case ast.Union:
+			for _, t := range def.Types {
+				filtered.AddPossibleType(def.Name, types[t])
+				filtered.AddImplements(t, def)
+			}
+		case ast.InputObject, ast.Object:
+			for _, intf := range def.Interfaces {
+				filtered.AddPossibleType(intf, def)
+				filtered.AddImplements(def.Name, types[intf])
+			}
+			filtered.AddPossibleType(def.Name, def)
+		case ast.Interface:
+			for _, intf := range def.Interfaces {
+				filtered.AddPossibleType(intf, def)
+				filtered.AddImplements(def.Name, types[intf])
+			}
+		}
+	}
+
+	return filtered
+}
+
+// isAlwaysVisible reports whether def is a built in type, which can not be hidden.
+func isAlwaysVisible(def *ast.Definition) bool {
+	return def.BuiltIn || strings.HasPrefix(def.Name, "__")
+}
+
+// filterDefinition returns a copy of def without its hidden elements. It returns false if def
+// is an input type that lost a required field, which clients could no longer provide.
+func filterDefinition(
+	def *ast.Definition,
+	hidden, hiddenFields map[string]bool,
+	visible func(coordinate string, directives ast.DirectiveList) bool,
+) (*ast.Definition, bool) {
+	filtered := *def
+	usable := true
+
+	filtered.Fields = nil
+	for _, field := range def.Fields {
+		coordinate := def.Name + "." + field.Name
+		if strings.HasPrefix(field.Name, "__") {
+			filtered.Fields = append(filtered.Fields, field)
+			continue
+		}
+		if hidden[field.Type.Name()] || hiddenFields[coordinate] ||
+			!visible(coordinate, field.Directives) {
+			if def.Kind == ast.InputObject && isRequired(field.Type, field.DefaultValue) {
+				usable = false
+			}
+			continue
+		}
+
+		var args ast.ArgumentDefinitionList
+		requiredArgHidden := false
+		for _, arg := range field.Arguments {
+			argCoordinate := coordinate + "(" + arg.Name + ":)"
+			if hidden[arg.Type.Name()] || hiddenFields[argCoordinate] ||
+				!visible(argCoordinate, arg.Directives) {
+				requiredArgHidden = requiredArgHidden || isRequired(arg.Type, arg.DefaultValue)
+				continue
+			}
+			args = append(args, arg)
+		}
+		if requiredArgHidden {
+			// clients could no longer select the field
+			continue
+		}
+		if len(args) != len(field.Arguments) {
+			copied := *field
+			copied.Arguments = args
+			field = &copied
+		}
+		filtered.Fields = append(filtered.Fields, field)
+	}
+
+	filtered.EnumValues = nil
+	for _, value := range def.EnumValues {
+		if visible(def.Name+"."+value.Name, value.Directives) {
+			filtered.EnumValues = append(filtered.EnumValues, value)
+		}
+	}
+
+	filtered.Interfaces = slices.DeleteFunc(slices.Clone(def.Interfaces), func(name string) bool {
+		return hidden[name]
+	})
+	filtered.Types = slices.DeleteFunc(slices.Clone(def.Types), func(name string) bool {
+		return hidden[name]
+	})
+
+	return &filtered, usable
+}
+
+// isRequired reports whether clients must provide a value for an argument or input field.
+func isRequired(t *ast.Type, defaultValue *ast.Value) bool {
+	return t.NonNull && defaultValue == nil
+}
+
+// hideUnimplementedFields adds the fields and arguments of interfaces in types that one of their
+// implementations no longer has to hiddenFields, and reports whether it added any.
+func hideUnimplementedFields(types map[string]*ast.Definition, hiddenFields map[string]bool) bool {
+	changed := false
+	hide := func(coordinate string) {
+		if !hiddenFields[coordinate] {
+			hiddenFields[coordinate] = true
+			changed = true
+		}
+	}
+
+	for _, def := range types {
+		for _, name := range def.Interfaces {
+			intf := types[name]
+			if intf == nil {
+				continue
+			}
+			for _, field := range intf.Fields {
+				coordinate := intf.Name + "." + field.Name
+				impl := def.Fields.ForName(field.Name)
+				if impl == nil {
+					hide(coordinate)
+					continue
+				}
+				for _, arg := range field.Arguments {
+					if impl.Arguments.ForName(arg.Name) == nil {
+						hide(coordinate + "(" + arg.Name + ":)")
+					}
+				}
+			}
+		}
+	}
+	return changed
+}
+
+func isEmptyDefinition(def *ast.Definition) bool {
+	switch def.Kind {
+	case ast.Object, ast.Interface, ast.InputObject:
+		for _, field := range def.Fields {
+			if !strings.HasPrefix(field.Name, "__") {
+				return false
+			}
+		}
+		return true
+	case ast.Union:
+		return len(def.Types) == 0
+	case ast.Enum:
+		return len(def.EnumValues) == 0
+	}
+	return false
+}
+
+func nameOf(def *ast.Definition) string {
+	if def == nil {
+		return ""
+	}
+	return def.Name
+}
+
+// TagFilter returns a FilterSchema visibility func for a schema contract selecting elements of
+// schema by their @tag(name:) directives, as used by Apollo Federation:
+//
+//   - elements tagged with any of the exclude tags are hidden, and so is everything on types
+//     tagged with them;
+//   - if include is not empty, object and interface fields are hidden unless they or their type
+//     are tagged with one of the include tags. Other elements are kept unless excluded, and types
+//     left without fields are hidden.
+func TagFilter(
+	schema *ast.Schema,
+	include, exclude []string,
+) func(coordinate string, directives ast.DirectiveList) bool {
+	return func(coordinate string, directives ast.DirectiveList) bool {
+		if hasTag(directives, exclude) {
+			return false
+		}
+		if len(include) == 0 {
+			return true
+		}
+
+		typeName, member, ok := strings.Cut(coordinate, ".")
+		if !ok || strings.Contains(member, "(") {
+			return true
+		}
+		parent := schema.Types[typeName]
+		if parent == nil || (parent.Kind != ast.Object && parent.Kind != ast.Interface) {
+			return true
+		}
+		return hasTag(directives, include) || hasTag(parent.Directives, include)
+	}
+}
+
+func hasTag(directives ast.DirectiveList, tags []string) bool {
+	for _, d := range directives.ForNames("tag") {
+		if arg := d.Arguments.ForName("name"); arg != nil && slices.Contains(tags, arg.Value.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContractSchema returns the schema contract of schema selected by TagFilter with the include and
+// exclude tags. Generated New<Name>ExecutableSchema constructors serve it.
+func ContractSchema(schema *ast.Schema, include, exclude []string) *ast.Schema {
+	return FilterSchema(schema, TagFilter(schema, include, exclude))
+}
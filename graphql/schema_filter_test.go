@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+)
+
+func TestContractSchema(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @tag(name: String!) repeatable on
+			| FIELD_DEFINITION | OBJECT | INTERFACE | ENUM_VALUE | ARGUMENT_DEFINITION
+
+		type Query {
+			users(filter: String @tag(name: "internal")): [User!]! @tag(name: "public")
+			partners: [Partner!]! @tag(name: "partner")
+			audit: [AuditLog!]!
+		}
+		type User {
+			id: ID! @tag(name: "public")
+			email: String! @tag(name: "internal")
+			role: Role @tag(name: "public")
+		}
+		type Partner @tag(name: "partner") {
+			id: ID!
+			secret: String! @tag(name: "internal")
+		}
+		type AuditLog @tag(name: "internal") {
+			message: String!
+		}
+		enum Role { ADMIN USER SERVICE @tag(name: "internal") }
+	`})
+
+	fieldNames := func(def *ast.Definition) []string {
+		if def == nil {
+			return nil
+		}
+		var names []string
+		for _, f := range def.Fields {
+			if !strings.HasPrefix(f.Name, "__") {
+				names = append(names, f.Name)
+			}
+		}
+		return names
+	}
+
+	tests := []struct {
+		name     string
+		include  []string
+		exclude  []string
+		query    []string
+		hidden   []string
+		user     []string
+		partner  []string
+		roles    int
+		userArgs int
+	}{
+		{
+			name:     "no tags keeps the schema",
+			query:    []string{"users", "partners", "audit"},
+			user:     []string{"id", "email", "role"},
+			partner:  []string{"id", "secret"},
+			roles:    3,
+			userArgs: 1,
+		},
+		{
+			name:    "exclude",
+			exclude: []string{"internal"},
+			query:   []string{"users", "partners"},
+			hidden:  []string{"AuditLog"},
+			user:    []string{"id", "role"},
+			partner: []string{"id"},
+			roles:   2,
+		},
+		{
+			name:     "include",
+			include:  []string{"public"},
+			query:    []string{"users"},
+			hidden:   []string{"AuditLog", "Partner"},
+			user:     []string{"id", "role"},
+			roles:    3,
+			userArgs: 1,
+		},
+		{
+			name:    "include by parent type",
+			include: []string{"partner"},
+			exclude: []string{"internal"},
+			query:   []string{"partners"},
+			hidden:  []string{"AuditLog", "User"},
+			partner: []string{"id"},
+			roles:   2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			contract := ContractSchema(schema, tc.include, tc.exclude)
+
+			require.Equal(t, tc.query, fieldNames(contract.Query))
+			for _, name := range tc.hidden {
+				require.NotContains(t, contract.Types, name)
+			}
+			require.Equal(t, tc.user, fieldNames(contract.Types["User"]))
+			require.Equal(t, tc.partner, fieldNames(contract.Types["Partner"]))
+			require.Len(t, contract.Types["Role"].EnumValues, tc.roles)
+			if users := contract.Query.Fields.ForName("users"); users != nil {
+				require.Len(t, users.Arguments, tc.userArgs)
+			}
+		})
+	}
+
+	require.Len(t, schema.Types["User"].Fields, 3, "the original schema is unchanged")
+}
+
+func TestFilterSchemaKeepsSchemaValid(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @tag(name: String!) repeatable on
+			| FIELD_DEFINITION | ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+		type Query {
+			search(term: String!, token: String! @tag(name: "internal")): [Node!]!
+			filter(term: String, token: String @tag(name: "internal")): [Node!]!
+			create(input: CreateInput!): Node
+			update(input: UpdateInput): Node
+		}
+		input CreateInput {
+			name: String!
+			secret: String! @tag(name: "internal")
+		}
+		input UpdateInput {
+			name: String
+			secret: String @tag(name: "internal")
+			limit: Int! = 10 @tag(name: "internal")
+		}
+		interface Node {
+			id: ID!
+			email: String
+			name(locale: String): String
+		}
+		type User implements Node {
+			id: ID!
+			email: String @tag(name: "internal")
+			name(locale: String @tag(name: "internal")): String
+		}
+		type Org implements Node {
+			id: ID!
+			email: String
+			name(locale: String): String
+		}
+	`})
+
+	filtered := ContractSchema(schema, nil, []string{"internal"})
+
+	var sdl bytes.Buffer
+	formatter.NewFormatter(&sdl).FormatSchema(filtered)
+	_, err := gqlparser.LoadSchema(&ast.Source{Input: sdl.String()})
+	require.NoError(t, err, sdl.String())
+
+	fieldNames := func(def *ast.Definition) []string {
+		var names []string
+		for _, f := range def.Fields {
+			if !strings.HasPrefix(f.Name, "__") {
+				names = append(names, f.Name)
+			}
+		}
+		return names
+	}
+	require.Equal(t, []string{"filter", "update"}, fieldNames(filtered.Query),
+		"fields with a hidden required argument are hidden")
+	require.NotContains(t, filtered.Types, "CreateInput",
+		"input types with a hidden required field are hidden")
+	require.Equal(t, []string{"name"}, fieldNames(filtered.Types["UpdateInput"]))
+	require.Equal(t, []string{"id", "name"}, fieldNames(filtered.Types["Node"]),
+		"interface fields missing from an implementation are hidden")
+	require.Empty(t, filtered.Types["Node"].Fields.ForName("name").Arguments)
+	require.Equal(t, []string{"id", "email", "name"}, fieldNames(filtered.Types["Org"]))
+}
@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// SemanticNonNullDirective is the name of the directive marking fields that are only null when
+// they have an error:
+//
+//	directive @semanticNonNull(levels: [Int] = [0]) on FIELD_DEFINITION
+//
+// Such fields stay nullable on the wire, so their errors do not propagate to their parents,
+// while gqlgen generates non-null Go types for them. The executor reports an error when their
+// resolver returns nil without an error.
+const SemanticNonNullDirective = "semanticNonNull"
+
+// SemanticNonNullLevels returns the levels of a field type marked as non-null by the
+// @semanticNonNull directive in directives, where 0 is the field itself and 1 the items of a
+// list, or nil when directives have no @semanticNonNull.
+func SemanticNonNullLevels(directives ast.DirectiveList) []int {
+	dir := directives.ForName(SemanticNonNullDirective)
+	if dir == nil {
+		return nil
+	}
+
+	values, ok := dir.ArgumentMap(nil)["levels"].([]any)
+	if !ok {
+		return []int{0}
+	}
+	levels := make([]int, 0, len(values))
+	for _, v := range values {
+		if level, ok := v.(int64); ok {
+			levels = append(levels, int(level))
+		}
+	}
+	return levels
+}
+
+// checkSemanticNonNull adds an error for each null value of res, the result of the field in fc,
+// at the semantically non-null levels.
+func checkSemanticNonNull(ctx context.Context, fc *FieldContext, levels []int, res any) {
+	maxLevel := slices.Max(levels)
+
+	var walk func(v reflect.Value, level int, path ast.Path)
+	walk = func(v reflect.Value, level int, path ast.Path) {
+		if isNilValue(v) {
+			if slices.Contains(levels, level) && (level > 0 || !HasFieldError(ctx, fc)) {
+				AddError(ctx, gqlerror.ErrorPathf(path, "must not be null"))
+			}
+			return
+		}
+		if level == maxLevel {
+			return
+		}
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return
+		}
+		for i := range v.Len() {
+			walk(v.Index(i), level+1, append(slices.Clip(path), ast.PathIndex(i)))
+		}
+	}
+	walk(reflect.ValueOf(res), 0, fc.Path())
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Map, reflect.Interface, reflect.Slice, reflect.Chan,
+		reflect.Func:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,500 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// SensitiveDirective is the name of the directive marking arguments, input fields and output
+// fields whose values must not appear in logs, traces or error messages:
+//
+//	directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+const SensitiveDirective = "sensitive"
+
+// Redacted replaces sensitive values.
+const Redacted = "[REDACTED]"
+
+// Sensitivity reports which values of operations on a schema are sensitive, according to the
+// arguments, input fields and output fields annotated with a directive such as @sensitive. The
+// executor sets OperationContext.Sensitivity when the schema declares @sensitive, so extensions
+// logging operations can redact their values.
+//
+// A nil *Sensitivity considers nothing sensitive.
+type Sensitivity struct {
+	schema    *ast.Schema
+	directive string
+}
+
+// NewSensitivity returns the Sensitivity of schema for the directive, or nil if schema does not
+// declare the directive.
+func NewSensitivity(schema *ast.Schema, directive string) *Sensitivity {
+	if schema == nil || schema.Directives[directive] == nil {
+		return nil
+	}
+	return &Sensitivity{schema: schema, directive: directive}
+}
+
+// IsSensitive reports whether directives mark a schema element as sensitive.
+func (s *Sensitivity) IsSensitive(directives ast.DirectiveList) bool {
+	return s != nil && directives.ForName(s.directive) != nil
+}
+
+// IsSensitivePath reports whether the value at path is sensitive. path is the path of a response
+// field, the path of an argument or input field of the field in ctx, as reported by argument
+// coercion errors, or the path of a variable, starting with "variable", as reported by variable
+// coercion errors. Response fields are sensitive when they or any of their parents are.
+func IsSensitivePath(ctx context.Context, path ast.Path) bool {
+	if !HasOperationContext(ctx) || len(path) == 0 {
+		return false
+	}
+	opCtx := GetOperationContext(ctx)
+	s := opCtx.Sensitivity
+	if s == nil {
+		return false
+	}
+
+	if isVariablePath(path) {
+		name, ok := path[1].(ast.PathName)
+		if !ok || opCtx.Operation == nil {
+			return false
+		}
+		if s.Variables(opCtx.Operation, opCtx.Doc)[string(name)] {
+			return true
+		}
+		def := opCtx.Operation.VariableDefinitions.ForName(string(name))
+		return def != nil && s.isSensitiveInput(def.Type, path[2:])
+	}
+
+	fc := GetFieldContext(ctx)
+	for it := fc; it != nil; it = it.Parent {
+		if it.Field.Field != nil && it.Field.Definition != nil &&
+			s.IsSensitive(it.Field.Definition.Directives) {
+			return true
+		}
+	}
+	if fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil {
+		return false
+	}
+
+	fieldPath := fc.Path()
+	if len(path) <= len(fieldPath) || !slices.Equal(path[:len(fieldPath)], fieldPath) {
+		return false
+	}
+	name, ok := path[len(fieldPath)].(ast.PathName)
+	if !ok {
+		return false
+	}
+	arg := fc.Field.Definition.Arguments.ForName(string(name))
+	if arg == nil {
+		return false
+	}
+	return s.IsSensitive(arg.Directives) || s.isSensitiveInput(arg.Type, path[len(fieldPath)+1:])
+}
+
+// isSensitiveInput reports whether the input value at path within a value of type typ is
+// sensitive.
+func (s *Sensitivity) isSensitiveInput(typ *ast.Type, path ast.Path) bool {
+	for _, elem := range path {
+		if typ == nil {
+			return false
+		}
+		switch elem := elem.(type) {
+		case ast.PathIndex:
+			typ = typ.Elem
+		case ast.PathName:
+			def := s.schema.Types[typ.Name()]
+			if def == nil {
+				return false
+			}
+			field := def.Fields.ForName(string(elem))
+			if field == nil {
+				return false
+			}
+			if s.IsSensitive(field.Directives) {
+				return true
+			}
+			typ = field.Type
+		}
+	}
+	return false
+}
+
+func isVariablePath(path ast.Path) bool {
+	return len(path) >= 2 && path[0] == ast.PathName("variable")
+}
+
+// Variables returns the names of the variables of op passed to sensitive arguments or input
+// fields.
+func (s *Sensitivity) Variables(
+	op *ast.OperationDefinition,
+	doc *ast.QueryDocument,
+) map[string]bool {
+	variables := map[string]bool{}
+	if s == nil || op == nil {
+		return variables
+	}
+
+	fragments := map[string]bool{}
+	var walk func(selections ast.SelectionSet)
+	walk = func(selections ast.SelectionSet) {
+		for _, selection := range selections {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				for _, arg := range selection.Arguments {
+					var def *ast.ArgumentDefinition
+					if selection.Definition != nil {
+						def = selection.Definition.Arguments.ForName(arg.Name)
+					}
+					s.walkValue(arg.Value, def != nil && s.IsSensitive(def.Directives), variables)
+				}
+				walk(selection.SelectionSet)
+			case *ast.InlineFragment:
+				walk(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				if fragments[selection.Name] || doc == nil {
+					continue
+				}
+				fragments[selection.Name] = true
+				if fragment := doc.Fragments.ForName(selection.Name); fragment != nil {
+					walk(fragment.SelectionSet)
+				}
+			}
+		}
+	}
+	walk(op.SelectionSet)
+
+	return variables
+}
+
+// walkValue adds the variables used in value at sensitive positions to variables.
+func (s *Sensitivity) walkValue(value *ast.Value, sensitive bool, variables map[string]bool) {
+	if value == nil {
+		return
+	}
+
+	switch value.Kind {
+	case ast.Variable:
+		if sensitive {
+			variables[value.Raw] = true
+		}
+	case ast.ListValue:
+		for _, child := range value.Children {
+			s.walkValue(child.Value, sensitive, variables)
+		}
+	case ast.ObjectValue:
+		var def *ast.Definition
+		if value.ExpectedType != nil {
+			def = s.schema.Types[value.ExpectedType.Name()]
+		}
+		for _, child := range value.Children {
+			childSensitive := sensitive
+			if def != nil {
+				if field := def.Fields.ForName(child.Name); field != nil {
+					childSensitive = childSensitive || s.IsSensitive(field.Directives)
+				}
+			}
+			s.walkValue(child.Value, childSensitive, variables)
+		}
+	}
+}
+
+// RedactVariables returns a copy of the variables of the operation with sensitive values
+// replaced by Redacted.
+func (s *Sensitivity) RedactVariables(opCtx *OperationContext) map[string]any {
+	sensitive := s.Variables(opCtx.Operation, opCtx.Doc)
+
+	variables := make(map[string]any, len(opCtx.Variables))
+	for name, value := range opCtx.Variables {
+		var def *ast.VariableDefinition
+		if opCtx.Operation != nil {
+			def = opCtx.Operation.VariableDefinitions.ForName(name)
+		}
+		switch {
+		case sensitive[name]:
+			variables[name] = Redacted
+		case def != nil:
+			variables[name] = s.RedactValue(value, def.Type)
+		default:
+			variables[name] = value
+		}
+	}
+	return variables
+}
+
+// RedactQuery returns the query document of the operation with the literal values of sensitive
+// arguments and input fields replaced by Redacted, for logging and tracing it. Variables are
+// printed by name; RedactVariables redacts their values. The document is reformatted unless s is
+// nil, and is empty if the operation could not be parsed.
+func (s *Sensitivity) RedactQuery(opCtx *OperationContext) string {
+	if s == nil {
+		return opCtx.RawQuery
+	}
+	if opCtx.Doc == nil {
+		return ""
+	}
+
+	doc := &ast.QueryDocument{
+		Operations: make(ast.OperationList, len(opCtx.Doc.Operations)),
+		Fragments:  make(ast.FragmentDefinitionList, len(opCtx.Doc.Fragments)),
+	}
+	for i, op := range opCtx.Doc.Operations {
+		copied := *op
+		copied.SelectionSet = s.redactLiterals(op.SelectionSet)
+		doc.Operations[i] = &copied
+	}
+	for i, fragment := range opCtx.Doc.Fragments {
+		copied := *fragment
+		copied.SelectionSet = s.redactLiterals(fragment.SelectionSet)
+		doc.Fragments[i] = &copied
+	}
+
+	var query strings.Builder
+	formatter.NewFormatter(&query).FormatQueryDocument(doc)
+	return query.String()
+}
+
+// redactLiterals returns a copy of selections with the literal values of sensitive arguments
+// redacted.
+func (s *Sensitivity) redactLiterals(selections ast.SelectionSet) ast.SelectionSet {
+	if selections == nil {
+		return nil
+	}
+	redacted := make(ast.SelectionSet, len(selections))
+	for i, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			field := *selection
+			field.Arguments = make(ast.ArgumentList, len(selection.Arguments))
+			for j, arg := range selection.Arguments {
+				var def *ast.ArgumentDefinition
+				if selection.Definition != nil {
+					def = selection.Definition.Arguments.ForName(arg.Name)
+				}
+				sensitive := def != nil && s.IsSensitive(def.Directives)
+				copied := *arg
+				copied.Value = s.redactLiteral(arg.Value, sensitive)
+				field.Arguments[j] = &copied
+			}
+			field.SelectionSet = s.redactLiterals(selection.SelectionSet)
+			redacted[i] = &field
+		case *ast.InlineFragment:
+			fragment := *selection
+			fragment.SelectionSet = s.redactLiterals(selection.SelectionSet)
+			redacted[i] = &fragment
+		default:
+			redacted[i] = selection
+		}
+	}
+	return redacted
+}
+
+// redactLiteral returns value, or a copy of it with sensitive literals replaced by Redacted.
+func (s *Sensitivity) redactLiteral(value *ast.Value, sensitive bool) *ast.Value {
+	if value == nil || value.Kind == ast.Variable {
+		return value
+	}
+	if sensitive {
+		return &ast.Value{Kind: ast.StringValue, Raw: Redacted, Position: value.Position}
+	}
+
+	switch value.Kind {
+	case ast.ListValue, ast.ObjectValue:
+		var def *ast.Definition
+		if value.Kind == ast.ObjectValue && value.ExpectedType != nil {
+			def = s.schema.Types[value.ExpectedType.Name()]
+		}
+		copied := *value
+		copied.Children = make(ast.ChildValueList, len(value.Children))
+		for i, child := range value.Children {
+			childSensitive := false
+			if def != nil {
+				if field := def.Fields.ForName(child.Name); field != nil {
+					childSensitive = s.IsSensitive(field.Directives)
+				}
+			}
+			copied.Children[i] = &ast.ChildValue{
+				Name:     child.Name,
+				Value:    s.redactLiteral(child.Value, childSensitive),
+				Position: child.Position,
+				Comment:  child.Comment,
+			}
+		}
+		return &copied
+	default:
+		return value
+	}
+}
+
+// RedactArguments returns the arguments of field, as returned by ast.Field.ArgumentMap, with
+// sensitive values replaced by Redacted.
+func (s *Sensitivity) RedactArguments(field *ast.Field, vars map[string]any) map[string]any {
+	if field == nil || field.Definition == nil {
+		return nil
+	}
+
+	args := field.ArgumentMap(vars)
+	for name, value := range args {
+		def := field.Definition.Arguments.ForName(name)
+		switch {
+		case def == nil:
+		case s.IsSensitive(def.Directives):
+			args[name] = Redacted
+		default:
+			args[name] = s.RedactValue(value, def.Type)
+		}
+	}
+	return args
+}
+
+// RedactValue returns a copy of value, a raw input value of type typ, with sensitive input
+// fields replaced by Redacted.
+func (s *Sensitivity) RedactValue(value any, typ *ast.Type) any {
+	if s == nil || typ == nil {
+		return value
+	}
+
+	switch value := value.(type) {
+	case []any:
+		redacted := make([]any, len(value))
+		for i, v := range value {
+			redacted[i] = s.RedactValue(v, typ.Elem)
+		}
+		return redacted
+	case map[string]any:
+		def := s.schema.Types[typ.Name()]
+		if def == nil {
+			return value
+		}
+		redacted := make(map[string]any, len(value))
+		for name, v := range value {
+			field := def.Fields.ForName(name)
+			switch {
+			case field == nil:
+				redacted[name] = v
+			case s.IsSensitive(field.Directives):
+				redacted[name] = Redacted
+			default:
+				redacted[name] = s.RedactValue(v, field.Type)
+			}
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// RedactResponse returns a copy of the data of a response to the operation with the values of
+// sensitive output fields replaced by Redacted. Fields are matched by their response key across
+// all fragments, so a field is redacted if it is sensitive on any of the types it is selected on.
+func (s *Sensitivity) RedactResponse(
+	opCtx *OperationContext,
+	data json.RawMessage,
+) json.RawMessage {
+	if s == nil || opCtx.Operation == nil || len(data) == 0 {
+		return data
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return data
+	}
+	value = s.redactSelections(opCtx.Doc, opCtx.Operation.SelectionSet, value)
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		return data
+	}
+	return redacted
+}
+
+func (s *Sensitivity) redactSelections(
+	doc *ast.QueryDocument,
+	selections ast.SelectionSet,
+	value any,
+) any {
+	switch value := value.(type) {
+	case []any:
+		for i, v := range value {
+			value[i] = s.redactSelections(doc, selections, v)
+		}
+		return value
+	case map[string]any:
+		for _, field := range flattenFields(doc, selections, map[string]bool{}) {
+			key := field.Alias
+			if key == "" {
+				key = field.Name
+			}
+			v, ok := value[key]
+			if !ok {
+				continue
+			}
+			if field.Definition != nil && s.IsSensitive(field.Definition.Directives) {
+				value[key] = Redacted
+				continue
+			}
+			value[key] = s.redactSelections(doc, field.SelectionSet, v)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// flattenFields returns the fields selected by selections, including those of fragments.
+func flattenFields(
+	doc *ast.QueryDocument,
+	selections ast.SelectionSet,
+	visited map[string]bool,
+) []*ast.Field {
+	var fields []*ast.Field
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, selection)
+		case *ast.InlineFragment:
+			fields = append(fields, flattenFields(doc, selection.SelectionSet, visited)...)
+		case *ast.FragmentSpread:
+			if visited[selection.Name] || doc == nil {
+				continue
+			}
+			visited[selection.Name] = true
+			if fragment := doc.Fragments.ForName(selection.Name); fragment != nil {
+				fields = append(fields, flattenFields(doc, fragment.SelectionSet, visited)...)
+			}
+		}
+	}
+	return fields
+}
+
+// redactSensitiveError replaces the message of coercion errors for sensitive arguments, input
+// fields and variables, as such messages can echo the invalid value. It runs before the error
+// presenter, so that neither the presenter nor what it logs sees the value.
+func redactSensitiveError(ctx context.Context, err error) error {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		return err
+	}
+	if !isCoercionError(err) && !isVariableError(gqlErr) ||
+		!IsSensitivePath(ctx, gqlErr.Path) {
+		return err
+	}
+	return &gqlerror.Error{
+		Message:    "invalid value for a sensitive input",
+		Path:       gqlErr.Path,
+		Locations:  gqlErr.Locations,
+		Extensions: gqlErr.Extensions,
+		Rule:       gqlErr.Rule,
+	}
+}
+
+// isVariableError reports whether err is a variable coercion error reported by the validator,
+// which does not wrap another error.
+func isVariableError(err *gqlerror.Error) bool {
+	return isVariablePath(err.Path) && err.Unwrap() == nil
+}
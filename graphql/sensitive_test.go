@@ -0,0 +1,253 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator/rules"
+)
+
+var sensitiveSchema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+	directive @sensitive on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+
+	type Query {
+		login(input: LoginInput!, token: String @sensitive): Session
+	}
+	type Session {
+		id: ID!
+		secret: String! @sensitive
+		user: User
+	}
+	type User {
+		name: String!
+	}
+	input LoginInput {
+		user: String!
+		password: String! @sensitive
+		devices: [Device!]
+	}
+	input Device {
+		name: String!
+		key: String! @sensitive
+	}
+`})
+
+func sensitiveOperation(t *testing.T, query string, vars map[string]any) *OperationContext {
+	t.Helper()
+
+	doc := gqlparser.MustLoadQueryWithRules(sensitiveSchema, query, rules.NewDefaultRules())
+	return &OperationContext{
+		RawQuery:    query,
+		Doc:         doc,
+		Operation:   doc.Operations[0],
+		Variables:   vars,
+		Sensitivity: NewSensitivity(sensitiveSchema, SensitiveDirective),
+	}
+}
+
+func TestNewSensitivity(t *testing.T) {
+	require.NotNil(t, NewSensitivity(sensitiveSchema, SensitiveDirective))
+	require.Nil(t, NewSensitivity(sensitiveSchema, "secret"))
+
+	var s *Sensitivity
+	require.False(t, s.IsSensitive(ast.DirectiveList{{Name: SensitiveDirective}}))
+	require.Equal(t, "value", s.RedactValue("value", &ast.Type{NamedType: "String"}))
+}
+
+func TestSensitivityRedact(t *testing.T) {
+	opCtx := sensitiveOperation(t, `
+		query($in: LoginInput!, $token: String, $pw: String!) {
+			login(input: $in, token: $token) { id secret user { name } }
+			other: login(input: {user: "bob", password: $pw}) { ...session }
+		}
+		fragment session on Session { secret }
+	`, map[string]any{
+		"in": map[string]any{
+			"user":     "bob",
+			"password": "hunter2",
+			"devices":  []any{map[string]any{"name": "phone", "key": "abc"}},
+		},
+		"token": "t",
+		"pw":    "hunter2",
+	})
+	s := opCtx.Sensitivity
+
+	require.Equal(t, map[string]bool{"token": true, "pw": true},
+		s.Variables(opCtx.Operation, opCtx.Doc))
+
+	require.Equal(t, map[string]any{
+		"in": map[string]any{
+			"user":     "bob",
+			"password": Redacted,
+			"devices":  []any{map[string]any{"name": "phone", "key": Redacted}},
+		},
+		"token": Redacted,
+		"pw":    Redacted,
+	}, s.RedactVariables(opCtx))
+
+	field := opCtx.Operation.SelectionSet[0].(*ast.Field)
+	require.Equal(t, map[string]any{
+		"input": map[string]any{
+			"user":     "bob",
+			"password": Redacted,
+			"devices":  []any{map[string]any{"name": "phone", "key": Redacted}},
+		},
+		"token": Redacted,
+	}, s.RedactArguments(field, opCtx.Variables))
+
+	data := s.RedactResponse(opCtx, []byte(
+		`{"login":{"id":"1","secret":"s","user":{"name":"bob"}},"other":{"secret":"s"}}`))
+	require.JSONEq(t,
+		`{"login":{"id":"1","secret":"[REDACTED]","user":{"name":"bob"}},`+
+			`"other":{"secret":"[REDACTED]"}}`,
+		string(data))
+}
+
+func TestSensitivityRedactQuery(t *testing.T) {
+	query := `query($pw: String!) {
+		login(input: {user: "bob", password: "hunter2", devices: [{name: "phone", key: "abc"}]},
+			token: "t0k3n") { id }
+		other: login(input: {user: "bob", password: $pw}) { ...session }
+	}
+	fragment session on Session { user { name } }`
+	opCtx := sensitiveOperation(t, query, map[string]any{"pw": "hunter2"})
+
+	redacted := opCtx.Sensitivity.RedactQuery(opCtx)
+	require.NotContains(t, redacted, "hunter2")
+	require.NotContains(t, redacted, "abc")
+	require.NotContains(t, redacted, "t0k3n")
+	require.Contains(t, redacted,
+		`login(input: {user:"bob",password:"[REDACTED]",devices:[{name:"phone",key:"[REDACTED]"}]}`)
+	require.Contains(t, redacted, `token: "[REDACTED]"`)
+	require.Contains(t, redacted, `password:$pw`)
+	require.Contains(t, redacted, "fragment session on Session")
+
+	opCtx.Sensitivity = nil
+	require.Equal(t, query, opCtx.Sensitivity.RedactQuery(opCtx))
+}
+
+func TestIsSensitivePath(t *testing.T) {
+	opCtx := sensitiveOperation(t, `
+		query($in: LoginInput!, $token: String) {
+			login(input: $in, token: $token) { id secret user { name } }
+		}
+	`, nil)
+	login := opCtx.Operation.SelectionSet[0].(*ast.Field)
+	loginCtx := &FieldContext{Field: CollectedField{Field: login}}
+	secretCtx := &FieldContext{
+		Parent: loginCtx,
+		Field:  CollectedField{Field: login.SelectionSet[1].(*ast.Field)},
+	}
+	userCtx := &FieldContext{
+		Parent: loginCtx,
+		Field:  CollectedField{Field: login.SelectionSet[2].(*ast.Field)},
+	}
+
+	tests := []struct {
+		name      string
+		fc        *FieldContext
+		path      ast.Path
+		sensitive bool
+	}{
+		{"variable", nil, ast.Path{ast.PathName("variable"), ast.PathName("token")}, true},
+		{
+			"variable input field",
+			nil,
+			ast.Path{ast.PathName("variable"), ast.PathName("in"), ast.PathName("password")},
+			true,
+		},
+		{
+			"nested variable input field",
+			nil,
+			ast.Path{
+				ast.PathName("variable"), ast.PathName("in"), ast.PathName("devices"),
+				ast.PathIndex(0), ast.PathName("key"),
+			},
+			true,
+		},
+		{
+			"plain variable input field",
+			nil,
+			ast.Path{ast.PathName("variable"), ast.PathName("in"), ast.PathName("user")},
+			false,
+		},
+		{"argument", loginCtx, ast.Path{ast.PathName("login"), ast.PathName("token")}, true},
+		{
+			"argument input field",
+			loginCtx,
+			ast.Path{ast.PathName("login"), ast.PathName("input"), ast.PathName("password")},
+			true,
+		},
+		{
+			"plain argument input field",
+			loginCtx,
+			ast.Path{ast.PathName("login"), ast.PathName("input"), ast.PathName("user")},
+			false,
+		},
+		{"output field", secretCtx, ast.Path{ast.PathName("login"), ast.PathName("secret")}, true},
+		{"plain output field", userCtx, ast.Path{ast.PathName("login"), ast.PathName("user")}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := WithOperationContext(context.Background(), opCtx)
+			if tc.fc != nil {
+				ctx = WithFieldContext(ctx, tc.fc)
+			}
+			require.Equal(t, tc.sensitive, IsSensitivePath(ctx, tc.path))
+		})
+	}
+
+	t.Run("without sensitivity", func(t *testing.T) {
+		ctx := WithOperationContext(context.Background(), &OperationContext{})
+		require.False(t, IsSensitivePath(ctx, ast.Path{ast.PathName("variable"), ast.PathName("x")}))
+	})
+}
+
+func TestAddErrorRedactsSensitiveInputs(t *testing.T) {
+	opCtx := sensitiveOperation(t, `
+		query($in: LoginInput!) { login(input: $in) { id } }
+	`, nil)
+	login := opCtx.Operation.SelectionSet[0].(*ast.Field)
+
+	var presented []string
+	presenter := func(ctx context.Context, err error) *gqlerror.Error {
+		presented = append(presented, err.Error())
+		return DefaultErrorPresenter(ctx, err)
+	}
+
+	ctx := WithOperationContext(context.Background(), opCtx)
+	ctx = WithResponseContext(ctx, presenter, DefaultRecover)
+	ctx = WithFieldContext(ctx, &FieldContext{Field: CollectedField{Field: login}})
+
+	AddError(ctx, gqlerror.ErrorPathf(
+		ast.Path{ast.PathName("variable"), ast.PathName("in"), ast.PathName("password")},
+		"hunter2 is not a valid password"))
+	AddError(ctx, CoercionErrorOnPath(WithPathContext(ctx, NewPathWithField("input")),
+		errors.New("invalid user bob")))
+	argCtx := WithPathContext(WithPathContext(ctx, NewPathWithField("input")),
+		NewPathWithField("password"))
+	AddError(ctx, CoercionErrorOnPath(argCtx, errors.New("invalid password hunter2")))
+	AddError(ctx, errors.New("login failed"))
+	AddError(argCtx, errors.New("password rejected by directive"))
+
+	errs := GetErrors(ctx)
+	require.Len(t, errs, 5)
+	require.Equal(t, "invalid value for a sensitive input", errs[0].Message)
+	require.Equal(t, "variable.in.password", errs[0].Path.String())
+	require.Equal(t, "invalid user bob", errs[1].Message)
+	require.Equal(t, "invalid value for a sensitive input", errs[2].Message)
+	require.Equal(t, "login.input.password", errs[2].Path.String())
+	require.Equal(t, "login failed", errs[3].Message)
+	require.Equal(t, "password rejected by directive", errs[4].Message,
+		"only coercion errors are redacted")
+
+	for _, msg := range presented {
+		require.NotContains(t, msg, "hunter2", "the presenter saw a sensitive value")
+	}
+}
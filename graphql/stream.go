@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// StreamDirective is the name of the directive streaming the items of list fields:
+//
+//	directive @stream(initialCount: Int = 0, label: String, if: Boolean = true) on FIELD
+//
+// Like @defer, operations can only use it when the schema declares it. The first initialCount
+// items are returned in the initial payload and the others are delivered as incremental payloads
+// as they resolve.
+const StreamDirective = "stream"
+
+// Streamable is the @stream directive of a collected field.
+type Streamable struct {
+	Label        string
+	InitialCount int
+}
+
+// StreamGroup is the rest of a list field streamed with @stream, whose items are delivered one
+// incremental payload each, at Path followed by their index.
+type StreamGroup struct {
+	Path    ast.Path
+	Label   string
+	Context context.Context
+	// Start is the index of the first streamed item.
+	Start int
+	// Next marshals the item at index, and returns false when there is none left.
+	Next func(ctx context.Context, index int) (Marshaler, bool)
+	// Stop releases the source of the items once they are delivered.
+	Stop func()
+}
+
+// StreamProcessor delivers the items of the lists streamed with @stream. The execution context
+// of the generated code implements it.
+type StreamProcessor interface {
+	ProcessStream(sg StreamGroup)
+}
+
+// SliceItems returns the items of a list field resolved as a slice.
+func SliceItems[E any](s []E) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqItems returns the items of a list field resolved as an iter.Seq, produced lazily.
+func SeqItems[E any](seq iter.Seq[E]) iter.Seq[E] {
+	if seq == nil {
+		return func(func(E) bool) {}
+	}
+	return seq
+}
+
+// ChanItems returns the items of a list field resolved as a channel, received until it is
+// closed.
+func ChanItems[E any](c <-chan E) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for v := range c {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// isNilItems reports whether the source of the items of a list field is nil, which resolves the
+// field to null.
+func isNilItems[S, E any](res S) bool {
+	switch items := any(res).(type) {
+	case []E:
+		return items == nil
+	case iter.Seq[E]:
+		return items == nil
+	case <-chan E:
+		return items == nil
+	}
+	return false
+}
+
+// collectItems returns the items of a list field as a slice.
+func collectItems[S, E any](res S, items func(S) iter.Seq[E]) []E {
+	if s, ok := any(res).([]E); ok {
+		return s
+	}
+	s := []E{}
+	for v := range items(res) {
+		s = append(s, v)
+	}
+	return s
+}
+
+func streamable(directives ast.DirectiveList, variables map[string]any) *Streamable {
+	d := directives.ForName(StreamDirective)
+	if d == nil {
+		return nil
+	}
+
+	stream := &Streamable{}
+	for _, arg := range d.Arguments {
+		value, err := arg.Value.Value(variables)
+		if err != nil {
+			continue
+		}
+		switch arg.Name {
+		case "if":
+			if enabled, ok := value.(bool); ok && !enabled {
+				return nil
+			}
+		case "label":
+			stream.Label, _ = value.(string)
+		case "initialCount":
+			if count, ok := value.(int64); ok {
+				stream.InitialCount = int(count)
+			}
+		}
+	}
+	return stream
+}
@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestStreamable(t *testing.T) {
+	parse := func(t *testing.T, query string) ast.DirectiveList {
+		t.Helper()
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		require.NoError(t, err)
+		return doc.Operations[0].SelectionSet[0].(*ast.Field).Directives
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		expected  *Streamable
+	}{
+		{name: "no directive", query: `{ names }`},
+		{name: "defaults", query: `{ names @stream }`, expected: &Streamable{}},
+		{
+			name:     "label and initialCount",
+			query:    `{ names @stream(label: "names", initialCount: 2) }`,
+			expected: &Streamable{Label: "names", InitialCount: 2},
+		},
+		{name: "disabled", query: `{ names @stream(if: false) }`},
+		{
+			name:      "from variables",
+			query:     `query($count: Int, $stream: Boolean) { names @stream(initialCount: $count, if: $stream) }`,
+			variables: map[string]any{"count": int64(3), "stream": true},
+			expected:  &Streamable{InitialCount: 3},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, streamable(parse(t, tc.query), tc.variables))
+		})
+	}
+}
+
+func TestCollectItems(t *testing.T) {
+	slice := []string{"a", "b"}
+	require.Equal(t, slice, collectItems(slice, SliceItems[string]))
+	require.Equal(t, slice, collectItems(slices.Values(slice), SeqItems[string]))
+
+	ch := make(chan string, 2)
+	ch <- "a"
+	ch <- "b"
+	close(ch)
+	require.Equal(t, slice, collectItems((<-chan string)(ch), ChanItems[string]))
+
+	var empty iter.Seq[string] = func(func(string) bool) {}
+	require.Equal(t, []string{}, collectItems(empty, SeqItems[string]))
+}
+
+func TestIsNilItems(t *testing.T) {
+	require.True(t, isNilItems[[]string, string](nil))
+	require.True(t, isNilItems[iter.Seq[string], string](nil))
+	require.True(t, isNilItems[<-chan string, string](nil))
+	require.False(t, isNilItems[[]string, string]([]string{}))
+	require.False(t, isNilItems[iter.Seq[string], string](slices.Values([]string{})))
+}
+
+func TestResponse_MarshalJSON(t *testing.T) {
+	hasNext := true
+	tests := []struct {
+		name     string
+		response Response
+		expected string
+	}{
+		{name: "null data", response: Response{}, expected: `{"data":null}`},
+		{
+			name:     "data",
+			response: Response{Data: []byte(`{"name":"a"}`), HasNext: &hasNext},
+			expected: `{"data":{"name":"a"},"hasNext":true}`,
+		},
+		{
+			name: "streamed items",
+			response: Response{
+				Items:   []byte(`["a"]`),
+				Path:    ast.Path{ast.PathName("names"), ast.PathIndex(1)},
+				HasNext: &hasNext,
+			},
+			expected: `{"items":["a"],"path":["names",1],"hasNext":true}`,
+		},
+		{
+			name:     "end of a stream",
+			response: Response{HasNext: new(bool)},
+			expected: `{"hasNext":false}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.response)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(b))
+		})
+	}
+}
+
+func TestResolveFieldList(t *testing.T) {
+	marshalList := func(ctx context.Context, sel ast.SelectionSet, v []string) Marshaler {
+		if v == nil {
+			return Null
+		}
+		ret := make(Array, len(v))
+		for i := range v {
+			ret[i] = MarshalString(v[i])
+		}
+		return ret
+	}
+	marshalItem := func(ctx context.Context, sel ast.SelectionSet, v string) Marshaler {
+		if v == "" {
+			AddErrorf(ctx, "must not be empty")
+			return Null
+		}
+		return MarshalString(v)
+	}
+
+	resolve := func(
+		t *testing.T,
+		operation ast.Operation,
+		stream *Streamable,
+		res iter.Seq[string],
+	) (string, []*Response) {
+		t.Helper()
+		ec := newTestExecutionContextState(
+			&OperationContext{
+				Operation: &ast.OperationDefinition{Operation: operation},
+				ResolverMiddleware: func(ctx context.Context, next Resolver) (any, error) {
+					return next(ctx)
+				},
+			},
+			nil,
+			nil,
+			make(chan DeferredResult),
+		)
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, DefaultRecover)
+		field := CollectedField{Field: &ast.Field{Alias: "names"}, Stream: stream}
+
+		ret := ResolveFieldList(
+			ctx,
+			ec.OperationContext,
+			ec,
+			field,
+			func(ctx context.Context, field CollectedField) (*FieldContext, error) {
+				return &FieldContext{Object: "Query", Field: field}, nil
+			},
+			func(ctx context.Context) (any, error) { return res, nil },
+			nil,
+			marshalList,
+			SeqItems[string],
+			marshalItem,
+			true,
+			true,
+			true,
+		)
+		var sb strings.Builder
+		ret.MarshalGQL(&sb)
+
+		var responses []*Response
+		for {
+			response := ec.NextDeferredResponse()
+			if response == nil {
+				return sb.String(), responses
+			}
+			responses = append(responses, response)
+		}
+	}
+	hasNext := func(v bool) *bool { return &v }
+
+	t.Run("without @stream", func(t *testing.T) {
+		data, responses := resolve(t, ast.Query, nil, slices.Values([]string{"a", "b"}))
+		require.Equal(t, `["a","b"]`, data)
+		require.Empty(t, responses)
+	})
+
+	t.Run("in a mutation", func(t *testing.T) {
+		data, responses := resolve(
+			t,
+			ast.Mutation,
+			&Streamable{},
+			slices.Values([]string{"a", "b"}),
+		)
+		require.Equal(t, `["a","b"]`, data)
+		require.Empty(t, responses)
+	})
+
+	t.Run("with fewer items than initialCount", func(t *testing.T) {
+		data, responses := resolve(
+			t,
+			ast.Query,
+			&Streamable{InitialCount: 3},
+			slices.Values([]string{"a", "b"}),
+		)
+		require.Equal(t, `["a","b"]`, data)
+		require.Empty(t, responses)
+	})
+
+	t.Run("streams the other items", func(t *testing.T) {
+		data, responses := resolve(
+			t,
+			ast.Query,
+			&Streamable{Label: "names", InitialCount: 1},
+			slices.Values([]string{"a", "b", "c"}),
+		)
+		require.Equal(t, `["a"]`, data)
+		require.Equal(t, []*Response{
+			{
+				Items:   json.RawMessage(`["b"]`),
+				Label:   "names",
+				Path:    ast.Path{ast.PathName("names"), ast.PathIndex(1)},
+				HasNext: hasNext(true),
+			},
+			{
+				Items:   json.RawMessage(`["c"]`),
+				Label:   "names",
+				Path:    ast.Path{ast.PathName("names"), ast.PathIndex(2)},
+				HasNext: hasNext(true),
+			},
+			{HasNext: hasNext(false)},
+		}, responses)
+	})
+
+	t.Run("ends the stream at a null item", func(t *testing.T) {
+		data, responses := resolve(
+			t,
+			ast.Query,
+			&Streamable{},
+			slices.Values([]string{"a", "", "c"}),
+		)
+		require.Equal(t, `[]`, data)
+		require.Len(t, responses, 3)
+		assert.Equal(t, json.RawMessage(`["a"]`), responses[0].Items)
+		assert.Equal(t, json.RawMessage(`null`), responses[1].Items)
+		require.Len(t, responses[1].Errors, 1)
+		assert.Equal(t, "must not be empty", responses[1].Errors[0].Message)
+		assert.Equal(
+			t,
+			ast.Path{ast.PathName("names"), ast.PathIndex(1)},
+			responses[1].Errors[0].Path,
+		)
+		assert.Equal(t, &Response{HasNext: hasNext(false)}, responses[2])
+	})
+}
@@ -51,6 +51,9 @@ type Interface struct {
 	Implements  []string
 	OmitCheck   bool
 	Models      []*Object
+	// Sealed interfaces have an unexported marker method, so that only the types of the
+	// generated package can implement them.
+	Sealed bool
 }
 
 type Object struct {
@@ -58,6 +61,10 @@ type Object struct {
 	Name        string
 	Fields      []*Field
 	Implements  []string
+	// Validate is set on input models when a Validate method is generated for them with the
+	// enable_model_input_validate option, and Validations are the checks it runs.
+	Validate    bool
+	Validations []*FieldValidation
 }
 
 type Field struct {
@@ -160,6 +167,15 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 				}
 				b.Interfaces = append(b.Interfaces, it)
 			}
+		case ast.InputObject:
+			if !userDefined && cfg.IsOneOfInterface(schemaType) {
+				it, members, err := m.getOneOf(cfg, binder, schemaType)
+				if err != nil {
+					return err
+				}
+				b.Interfaces = append(b.Interfaces, it)
+				b.Models = append(b.Models, members...)
+			}
 		}
 	}
 
@@ -169,6 +185,9 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 		}
 		switch schemaType.Kind {
 		case ast.Object, ast.InputObject:
+			if cfg.IsOneOfInterface(schemaType) {
+				continue
+			}
 			it, err := m.getObject(cfg, schemaType, b)
 			if err != nil {
 				return err
@@ -200,6 +219,7 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 
 	sort.Slice(b.Enums, func(i, j int) bool { return b.Enums[i].Name < b.Enums[j].Name })
 	sort.Slice(b.Models, func(i, j int) bool { return b.Models[i].Name < b.Models[j].Name })
+	nameValidationRules(b.Models)
 	sort.Slice(
 		b.Interfaces,
 		func(i, j int) bool { return b.Interfaces[i].Name < b.Interfaces[j].Name },
@@ -447,6 +467,15 @@ func (m *Plugin) generateField(
 			)
 
 		case ast.Object, ast.InputObject:
+			if cfg.IsOneOfInterface(fieldDef) {
+				// no user defined model, referencing a generated @oneOf interface type
+				typ = types.NewNamed(
+					types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(field.Type.Name()), nil),
+					types.NewInterfaceType([]*types.Func{}, []types.Type{}),
+					nil,
+				)
+				break
+			}
 			// no user defined model, must reference a generated struct
 			typ = types.NewNamed(
 				types.NewTypeName(
@@ -469,7 +498,7 @@ func (m *Plugin) generateField(
 		name = nameOverride
 	}
 
-	typ = binder.CopyModifiersFromAst(field.Type, typ)
+	typ = binder.CopyModifiersFromAst(config.SemanticNonNullType(field), typ)
 
 	if cfg.StructFieldsAlwaysPointers {
 		if isStruct(typ) && (fieldDef.Kind == ast.Object || fieldDef.Kind == ast.InputObject) {
@@ -601,13 +630,14 @@ func getExtraFields(cfg *config.Config, modelName string) []*Field {
 
 func getStructTagFromField(cfg *config.Config, field *ast.FieldDefinition) string {
 	var tags []string
+	nonNull := config.SemanticNonNullType(field).NonNull
 
-	if !field.Type.NonNull &&
+	if !nonNull &&
 		(cfg.EnableModelJsonOmitemptyTag == nil || *cfg.EnableModelJsonOmitemptyTag) {
 		tags = append(tags, "omitempty")
 	}
 
-	if !field.Type.NonNull &&
+	if !nonNull &&
 		(cfg.EnableModelJsonOmitzeroTag == nil || *cfg.EnableModelJsonOmitzeroTag) {
 		tags = append(tags, "omitzero")
 	}
@@ -854,6 +884,57 @@ func (m *Plugin) getInterface(
 	return it, nil
 }
 
+// getOneOf returns the interface generated for the @oneOf input object schemaType, and the
+// types implementing it, which hold the non-null value of one of its fields each.
+func (m *Plugin) getOneOf(
+	cfg *config.Config,
+	binder *config.Binder,
+	schemaType *ast.Definition,
+) (*Interface, []*Object, error) {
+	it := &Interface{
+		Description: schemaType.Description,
+		Name:        schemaType.Name,
+		Sealed:      true,
+	}
+
+	members := make([]*Object, 0, len(schemaType.Fields))
+	for _, field := range schemaType.Fields {
+		memberField := *field
+		memberField.Type = &ast.Type{
+			NamedType: field.Type.NamedType,
+			Elem:      field.Type.Elem,
+			NonNull:   true,
+			Position:  field.Type.Position,
+		}
+		f, err := m.generateField(cfg, binder, schemaType, &memberField)
+		if err != nil {
+			return nil, nil, err
+		}
+		if f == nil {
+			continue
+		}
+		f.Description = ""
+
+		member := &Object{
+			Description: field.Description,
+			Name:        config.OneOfMemberName(templates.ToGoModelName(schemaType.Name), field),
+			Fields:      []*Field{f},
+			Implements:  []string{schemaType.Name},
+		}
+		if cfg.EnableModelInputValidate {
+			def := &ast.Definition{Name: schemaType.Name, Fields: ast.FieldList{&memberField}}
+			member.Validate = true
+			member.Validations, err = fieldValidations(cfg, def, member.Fields)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		members = append(members, member)
+	}
+
+	return it, members, nil
+}
+
 func (m *Plugin) getObject(
 	cfg *config.Config,
 	schemaType *ast.Definition,
@@ -881,6 +962,14 @@ func (m *Plugin) getObject(
 		Fields:      fields,
 	}
 
+	if schemaType.Kind == ast.InputObject && cfg.EnableModelInputValidate {
+		it.Validate = true
+		it.Validations, err = fieldValidations(cfg, schemaType, fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If Interface A implements interface B, and Interface C also implements interface B
 	// then both A and C have methods of B.
 	// The reason for checking unique is to prevent the same method B from being generated twice.
@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"golang.org/x/tools/go/packages"
 
 	"github.com/99designs/gqlgen/codegen/config"
@@ -31,6 +32,7 @@ import (
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_nil"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_true"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_input_validate"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_nullable_input_omittable"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_struct_pointers"
 )
@@ -125,6 +127,27 @@ func TestModelGeneration(t *testing.T) {
 		var _ out.FooBarer = out.FooBarr{}
 	})
 
+	t.Run("oneOf inputs are interfaces implemented by their members", func(t *testing.T) {
+		var _ out.OneOfInput = out.OneOfInputName{Name: "name"}
+		var _ out.OneOfInput = out.OneOfInputMissing{Missing: &out.MissingInput{}}
+		var _ out.OneOfInput = out.OneOfInputExisting{Existing: &out.ExistingInput{}}
+
+		parent := out.OneOfParentInput{Children: []out.OneOfInput{out.OneOfInputName{}}}
+		require.Nil(t, parent.Child)
+		require.Equal(
+			t,
+			config.StringList{"github.com/99designs/gqlgen/plugin/modelgen/out.OneOfInput"},
+			cfg.Models["OneOfInput"].Model,
+		)
+	})
+
+	t.Run("oneOf inputs are structs without oneof_interfaces", func(t *testing.T) {
+		name := "name"
+		input := out_struct_pointers.OneOfInput{Name: &name}
+		require.Nil(t, input.Missing)
+		require.Nil(t, input.Existing)
+	})
+
 	t.Run("implemented interfaces", func(t *testing.T) {
 		generated := parseGeneratedFile(t, "out")
 
@@ -470,6 +493,84 @@ func TestModelGenerationNullableInputOmittable(t *testing.T) {
 	})
 }
 
+func TestModelGenerationInputValidate(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_input_validate.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := Plugin{
+		MutateHook: mutateHook,
+		FieldHook:  DefaultFieldMutateHook,
+	}
+	require.NoError(t, p.MutateConfig(cfg))
+	require.NoError(t, goBuild(t, "./out_input_validate/"))
+
+	t.Run("valid input", func(t *testing.T) {
+		input := out_input_validate.OrderInput{
+			Email:   "me@example.com",
+			Items:   []*out_input_validate.ItemInput{{Name: "pen", Quantity: 2}},
+			Payment: out_input_validate.PaymentInputCard{Card: "4242424242424242"},
+		}
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("violations carry the paths of the fields", func(t *testing.T) {
+		note := "a very long note"
+		input := out_input_validate.OrderInput{
+			Email: "me",
+			Note:  &note,
+			Items: []*out_input_validate.ItemInput{
+				{Name: "pen", Quantity: 1},
+				{Name: "", Quantity: 0},
+				nil,
+			},
+			Tags:    []string{"ok", "x"},
+			Payment: out_input_validate.PaymentInputCard{Card: "42"},
+		}
+
+		var errs gqlerror.List
+		require.ErrorAs(t, input.Validate(), &errs)
+		violations := make([]string, 0, len(errs))
+		for _, err := range errs {
+			violations = append(violations, err.Error())
+		}
+		require.Equal(t, []string{
+			"input: email must be a valid email",
+			"input: note must be at most 10 characters long",
+			"input: items[1].name must be at least 1 characters long",
+			"input: items[1].quantity must be greater than or equal to 1",
+			"input: items[2] must not be null",
+			"input: tags[1] must be at least 2 characters long",
+			"input: payment.card must match the pattern ^[0-9]{16}$",
+		}, violations)
+	})
+
+	t.Run("non-null lists must be set", func(t *testing.T) {
+		input := out_input_validate.OrderInput{Email: "me@example.com"}
+		var errs gqlerror.List
+		require.ErrorAs(t, input.Validate(), &errs)
+		require.Len(t, errs, 1)
+		require.Equal(t, "input: items must not be null", errs[0].Error())
+	})
+}
+
+func TestNameValidationRules(t *testing.T) {
+	rules := func(name string) *FieldValidation {
+		return &FieldValidation{Name: name, Rules: "graphql.MinLength(1)"}
+	}
+	foo := &Object{Name: "Foo", Validations: []*FieldValidation{
+		rules("bar_baz"),
+		{Name: "id", NonNull: "[]int{0}"},
+	}}
+	fooBar := &Object{Name: "Foo_bar", Validations: []*FieldValidation{rules("baz")}}
+
+	nameValidationRules([]*Object{foo, fooBar})
+
+	require.Equal(t, "validateFoo_bar_baz_constraints", foo.Validations[0].RulesVar)
+	require.Empty(t, foo.Validations[1].RulesVar)
+	require.Equal(t, "validateFoo_bar_baz_constraints2", fooBar.Validations[0].RulesVar,
+		"names that are already taken are numbered")
+}
+
 func TestModelGenerationOmitemptyConfig(t *testing.T) {
 	suites := []struct {
 		n       string
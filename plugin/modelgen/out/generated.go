@@ -64,6 +64,11 @@ type MissingUnion interface {
 	IsMissingUnion()
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput interface {
+	isOneOfInput()
+}
+
 // UnionWithDescription is an union with a description
 type UnionWithDescription interface {
 	IsUnionWithDescription()
@@ -218,6 +223,30 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value,omitempty" database:"OmitZeroJSONTagTestValue"`
 }
 
+type OneOfInputExisting struct {
+	Existing *ExistingInput `json:"existing" database:"OneOfInputExistingexisting"`
+}
+
+func (OneOfInputExisting) isOneOfInput() {}
+
+type OneOfInputMissing struct {
+	Missing *MissingInput `json:"missing" database:"OneOfInputMissingmissing"`
+}
+
+func (OneOfInputMissing) isOneOfInput() {}
+
+// The name of the thing
+type OneOfInputName struct {
+	Name string `json:"name" database:"OneOfInputNamename"`
+}
+
+func (OneOfInputName) isOneOfInput() {}
+
+type OneOfParentInput struct {
+	Child    OneOfInput   `json:"child,omitempty" database:"OneOfParentInputchild"`
+	Children []OneOfInput `json:"children,omitempty" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
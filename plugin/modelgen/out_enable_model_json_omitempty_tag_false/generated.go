@@ -213,6 +213,19 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value" database:"OmitZeroJSONTagTestValue"`
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput struct {
+	// The name of the thing
+	Name     *string            `json:"name" database:"OneOfInputname"`
+	Missing  *MissingInput      `json:"missing" database:"OneOfInputmissing"`
+	Existing *out.ExistingInput `json:"existing" database:"OneOfInputexisting"`
+}
+
+type OneOfParentInput struct {
+	Child    *OneOfInput   `json:"child" database:"OneOfParentInputchild"`
+	Children []*OneOfInput `json:"children" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
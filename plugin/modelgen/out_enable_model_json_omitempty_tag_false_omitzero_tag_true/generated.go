@@ -213,6 +213,19 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value,omitzero" database:"OmitZeroJSONTagTestValue"`
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput struct {
+	// The name of the thing
+	Name     *string            `json:"name,omitzero" database:"OneOfInputname"`
+	Missing  *MissingInput      `json:"missing,omitzero" database:"OneOfInputmissing"`
+	Existing *out.ExistingInput `json:"existing,omitzero" database:"OneOfInputexisting"`
+}
+
+type OneOfParentInput struct {
+	Child    *OneOfInput   `json:"child,omitzero" database:"OneOfParentInputchild"`
+	Children []*OneOfInput `json:"children,omitzero" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
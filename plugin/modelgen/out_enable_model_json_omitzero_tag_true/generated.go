@@ -213,6 +213,19 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value,omitempty,omitzero" database:"OmitZeroJSONTagTestValue"`
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput struct {
+	// The name of the thing
+	Name     *string            `json:"name,omitempty,omitzero" database:"OneOfInputname"`
+	Missing  *MissingInput      `json:"missing,omitempty,omitzero" database:"OneOfInputmissing"`
+	Existing *out.ExistingInput `json:"existing,omitempty,omitzero" database:"OneOfInputexisting"`
+}
+
+type OneOfParentInput struct {
+	Child    *OneOfInput   `json:"child,omitempty,omitzero" database:"OneOfParentInputchild"`
+	Children []*OneOfInput `json:"children,omitempty,omitzero" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
@@ -0,0 +1,86 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_input_validate
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type PaymentInput interface {
+	isPaymentInput()
+}
+
+type ItemInput struct {
+	Name     string `json:"name" database:"ItemInputname"`
+	Quantity int    `json:"quantity" database:"ItemInputquantity"`
+}
+
+var validateItemInput_name_constraints = []graphql.ConstraintRule{graphql.MinLength(1), graphql.MaxLength(20)}
+
+var validateItemInput_quantity_constraints = []graphql.ConstraintRule{graphql.Min(1)}
+
+// Validate checks that ItemInput satisfies the schema, returning the violations as a gqlerror.List.
+func (this ItemInput) Validate() error {
+	var v graphql.InputValidation
+	v.Field("name", this.Name, nil, validateItemInput_name_constraints...)
+	v.Field("quantity", this.Quantity, nil, validateItemInput_quantity_constraints...)
+	return v.Err()
+}
+
+type OrderInput struct {
+	Email    string       `json:"email" database:"OrderInputemail"`
+	Note     *string      `json:"note,omitempty" database:"OrderInputnote"`
+	Items    []*ItemInput `json:"items" database:"OrderInputitems"`
+	Tags     []string     `json:"tags,omitempty" database:"OrderInputtags"`
+	Payment  PaymentInput `json:"payment,omitempty" database:"OrderInputpayment"`
+	GiftWrap *bool        `json:"giftWrap,omitempty" database:"OrderInputgiftWrap"`
+}
+
+var validateOrderInput_email_constraints = []graphql.ConstraintRule{graphql.Format("email")}
+
+var validateOrderInput_note_constraints = []graphql.ConstraintRule{graphql.MaxLength(10)}
+
+var validateOrderInput_items_constraints = []graphql.ConstraintRule{graphql.MinItems(1), graphql.MaxItems(3)}
+
+var validateOrderInput_tags_constraints = []graphql.ConstraintRule{graphql.MinLength(2)}
+
+// Validate checks that OrderInput satisfies the schema, returning the violations as a gqlerror.List.
+func (this OrderInput) Validate() error {
+	var v graphql.InputValidation
+	v.Field("email", this.Email, nil, validateOrderInput_email_constraints...)
+	v.Field("note", this.Note, nil, validateOrderInput_note_constraints...)
+	v.Field("items", this.Items, []int{0, 1}, validateOrderInput_items_constraints...)
+	v.Field("tags", this.Tags, nil, validateOrderInput_tags_constraints...)
+	v.Field("payment", this.Payment, nil)
+	return v.Err()
+}
+
+type PaymentInputCard struct {
+	Card string `json:"card" database:"PaymentInputCardcard"`
+}
+
+var validatePaymentInputCard_card_constraints = []graphql.ConstraintRule{graphql.Pattern("^[0-9]{16}$")}
+
+// Validate checks that PaymentInputCard satisfies the schema, returning the violations as a gqlerror.List.
+func (this PaymentInputCard) Validate() error {
+	var v graphql.InputValidation
+	v.Field("card", this.Card, nil, validatePaymentInputCard_card_constraints...)
+	return v.Err()
+}
+
+func (PaymentInputCard) isPaymentInput() {}
+
+type PaymentInputVoucher struct {
+	Voucher string `json:"voucher" database:"PaymentInputVouchervoucher"`
+}
+
+// Validate checks that PaymentInputVoucher satisfies the schema, returning the violations as a gqlerror.List.
+func (this PaymentInputVoucher) Validate() error {
+	var v graphql.InputValidation
+	return v.Err()
+}
+
+func (PaymentInputVoucher) isPaymentInput() {}
+
+type Query struct {
+}
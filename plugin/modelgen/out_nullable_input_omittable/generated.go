@@ -212,6 +212,19 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value,omitempty" database:"OmitZeroJSONTagTestValue"`
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput struct {
+	// The name of the thing
+	Name     graphql.Omittable[*string]        `json:"name,omitempty" database:"OneOfInputname"`
+	Missing  graphql.Omittable[*MissingInput]  `json:"missing,omitempty" database:"OneOfInputmissing"`
+	Existing graphql.Omittable[*ExistingInput] `json:"existing,omitempty" database:"OneOfInputexisting"`
+}
+
+type OneOfParentInput struct {
+	Child    graphql.Omittable[*OneOfInput]   `json:"child,omitempty" database:"OneOfParentInputchild"`
+	Children graphql.Omittable[[]*OneOfInput] `json:"children,omitempty" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
@@ -243,6 +243,19 @@ type OmitZeroJSONTagTest struct {
 	Value       *string `json:"Value,omitempty" database:"OmitZeroJSONTagTestValue"`
 }
 
+// Exactly one of the fields of OneOfInput is given
+type OneOfInput struct {
+	// The name of the thing
+	Name     *string        `json:"name,omitempty" database:"OneOfInputname"`
+	Missing  *MissingInput  `json:"missing,omitempty" database:"OneOfInputmissing"`
+	Existing *ExistingInput `json:"existing,omitempty" database:"OneOfInputexisting"`
+}
+
+type OneOfParentInput struct {
+	Child    *OneOfInput   `json:"child,omitempty" database:"OneOfParentInputchild"`
+	Children []*OneOfInput `json:"children,omitempty" database:"OneOfParentInputchildren"`
+}
+
 type Query struct {
 }
 
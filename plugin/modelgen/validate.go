@@ -0,0 +1,137 @@
+package modelgen
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+// FieldValidation is a check of the Validate method generated for input models with the
+// enable_model_input_validate option.
+type FieldValidation struct {
+	// Name is the field's name as it appears in the schema
+	Name string
+	// Value is the Go expression of the field's value on the model, e.g. Name or Name.Value()
+	Value string
+	// NonNull is the Go expression of the levels of the field's type that must not be null,
+	// e.g. []int{0, 1} for [String!]!, or nil
+	NonNull string
+	// Rules are the Go expressions of the graphql.ConstraintRule values of the field
+	Rules string
+	// RulesVar is the name of the package level variable holding Rules, so that they are built
+	// once rather than on every call of Validate
+	RulesVar string
+}
+
+// fieldValidations returns the checks of the fields of the input object schemaType generated as
+// fields, skipping those with nothing to check.
+func fieldValidations(
+	cfg *config.Config,
+	schemaType *ast.Definition,
+	fields []*Field,
+) ([]*FieldValidation, error) {
+	validations := make([]*FieldValidation, 0, len(fields))
+	for _, f := range fields {
+		field := schemaType.Fields.ForName(f.Name)
+		if field == nil || f.GoName == "" {
+			continue
+		}
+
+		var rules string
+		if cfg.Directives[config.DirConstraint].SkipRuntime {
+			var err error
+			rules, err = config.ConstraintRules(field.Directives)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"generror: field %v.%v: %w",
+					schemaType.Name,
+					field.Name,
+					err,
+				)
+			}
+		}
+
+		value, typ := f.GoName, f.Type
+		if f.Omittable {
+			value += ".Value()"
+			if named, ok := typ.(*types.Named); ok && named.TypeArgs().Len() == 1 {
+				typ = named.TypeArgs().At(0)
+			}
+		}
+
+		levels := nonNullLevels(field.Type, typ)
+		def := cfg.Schema.Types[field.Type.Name()]
+		if rules == "" && len(levels) == 0 && (def == nil || def.Kind != ast.InputObject) {
+			continue
+		}
+
+		nonNull := "nil"
+		if len(levels) > 0 {
+			nonNull = "[]int{" + strings.Join(levels, ", ") + "}"
+		}
+		validations = append(validations, &FieldValidation{
+			Name:    field.Name,
+			Value:   value,
+			NonNull: nonNull,
+			Rules:   rules,
+		})
+	}
+	return validations, nil
+}
+
+// nameValidationRules sets the RulesVar of the validations of models. Names join the GraphQL
+// names of the model and field with underscores after an unexported prefix, so they cannot clash
+// with the exported identifiers of the generated package and are unlikely in handwritten code.
+// GraphQL names can contain underscores too, so a number is appended to the names already taken.
+func nameValidationRules(models []*Object) {
+	taken := map[string]bool{}
+	for _, model := range models {
+		for _, validation := range model.Validations {
+			if validation.Rules == "" {
+				continue
+			}
+			name := "validate" + model.Name + "_" + validation.Name + "_constraints"
+			candidate := name
+			for i := 2; taken[candidate]; i++ {
+				candidate = fmt.Sprintf("%s%d", name, i)
+			}
+			taken[candidate] = true
+			validation.RulesVar = candidate
+		}
+	}
+}
+
+// nonNullLevels returns the levels of t, 0 being t itself and 1 the items of a list, that are
+// non-null in the schema and can be nil in typ, the Go type of t.
+func nonNullLevels(t *ast.Type, typ types.Type) []string {
+	var levels []string
+	for level := 0; t != nil && typ != nil; level++ {
+		if t.NonNull && isNilable(typ) {
+			levels = append(levels, strconv.Itoa(level))
+		}
+
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if slice, ok := typ.Underlying().(*types.Slice); ok {
+			typ = slice.Elem()
+		} else {
+			typ = nil
+		}
+		t = t.Elem
+	}
+	return levels
+}
+
+func isNilable(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Interface, *types.Map:
+		return true
+	}
+	return false
+}